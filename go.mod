@@ -4,7 +4,11 @@ go 1.15
 
 require (
 	github.com/google/go-querystring v1.0.0
-	github.com/stretchr/testify v1.5.1
-	golang.org/x/net v0.0.0-20190108225652-1e06a53dbb7e
+	github.com/prometheus/client_golang v1.11.0
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/otel v1.0.1
+	go.opentelemetry.io/otel/sdk v1.0.1
+	go.opentelemetry.io/otel/trace v1.0.1
+	golang.org/x/net v0.0.0-20200625001655-4c5254603344
 	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d
 )
@@ -2,6 +2,8 @@ package reddit
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 )
@@ -215,6 +217,58 @@ type rootRelationshipList struct {
 	} `json:"data"`
 }
 
+// userLists holds the user lists returned by the prefs endpoints.
+// Depending on the endpoint, Reddit returns either a single UserList
+// object, or an array of them.
+type userLists []rootRelationshipList
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (l *userLists) UnmarshalJSON(b []byte) error {
+	var lists []rootRelationshipList
+	if len(b) > 0 && b[0] == '{' {
+		list := rootRelationshipList{}
+		if err := json.Unmarshal(b, &list); err != nil {
+			return err
+		}
+		lists = append(lists, list)
+	} else if err := json.Unmarshal(b, &lists); err != nil {
+		return err
+	}
+
+	for _, list := range lists {
+		if list.Kind != kindUserList {
+			return fmt.Errorf("unexpected kind %q, expected %q", list.Kind, kindUserList)
+		}
+	}
+
+	*l = lists
+	return nil
+}
+
+// get returns the relationships of the list at index i, or nil if there's no such list.
+func (l userLists) get(i int) []Relationship {
+	if i >= len(l) {
+		return nil
+	}
+	return l[i].Data.Relationships
+}
+
+// getUserLists gets the user lists at the path.
+func (s *AccountService) getUserLists(ctx context.Context, path string) (userLists, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var root userLists
+	resp, err := s.client.Do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
 // Info returns some general information about your account.
 func (s *AccountService) Info(ctx context.Context) (*User, *Response, error) {
 	path := "api/v1/me"
@@ -294,76 +348,42 @@ func (s *AccountService) Trophies(ctx context.Context) ([]*Trophy, *Response, er
 // Friends returns a list of your friends.
 func (s *AccountService) Friends(ctx context.Context) ([]Relationship, *Response, error) {
 	path := "prefs/friends"
-
-	req, err := s.client.NewRequest(http.MethodGet, path, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	var root [2]rootRelationshipList
-	resp, err := s.client.Do(ctx, req, &root)
+	lists, resp, err := s.getUserLists(ctx, path)
 	if err != nil {
 		return nil, resp, err
 	}
-
-	return root[0].Data.Relationships, resp, nil
+	return lists.get(0), resp, nil
 }
 
 // Blocked returns a list of your blocked users.
 func (s *AccountService) Blocked(ctx context.Context) ([]Relationship, *Response, error) {
 	path := "prefs/blocked"
-
-	req, err := s.client.NewRequest(http.MethodGet, path, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	root := new(rootRelationshipList)
-	resp, err := s.client.Do(ctx, req, root)
+	lists, resp, err := s.getUserLists(ctx, path)
 	if err != nil {
 		return nil, resp, err
 	}
-
-	return root.Data.Relationships, resp, nil
+	return lists.get(0), resp, nil
 }
 
 // Messaging returns blocked users and trusted users, respectively.
+// If Reddit doesn't return the list of trusted users, it is nil.
 func (s *AccountService) Messaging(ctx context.Context) ([]Relationship, []Relationship, *Response, error) {
 	path := "prefs/messaging"
-
-	req, err := s.client.NewRequest(http.MethodGet, path, nil)
-	if err != nil {
-		return nil, nil, nil, err
-	}
-
-	var root [2]rootRelationshipList
-	resp, err := s.client.Do(ctx, req, &root)
+	lists, resp, err := s.getUserLists(ctx, path)
 	if err != nil {
 		return nil, nil, resp, err
 	}
-
-	blocked := root[0].Data.Relationships
-	trusted := root[1].Data.Relationships
-
-	return blocked, trusted, resp, nil
+	return lists.get(0), lists.get(1), resp, nil
 }
 
 // Trusted returns a list of your trusted users.
 func (s *AccountService) Trusted(ctx context.Context) ([]Relationship, *Response, error) {
 	path := "prefs/trusted"
-
-	req, err := s.client.NewRequest(http.MethodGet, path, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	root := new(rootRelationshipList)
-	resp, err := s.client.Do(ctx, req, root)
+	lists, resp, err := s.getUserLists(ctx, path)
 	if err != nil {
 		return nil, resp, err
 	}
-
-	return root.Data.Relationships, resp, nil
+	return lists.get(0), resp, nil
 }
 
 // AddTrusted adds a user to your trusted users.
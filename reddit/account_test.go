@@ -12,13 +12,23 @@ import (
 )
 
 var expectedInfo = &User{
-	ID:               "164ab8",
-	Name:             "v_95",
-	Created:          &Timestamp{time.Date(2017, 3, 12, 4, 56, 47, 0, time.UTC)},
-	PostKarma:        488,
-	CommentKarma:     22223,
+	ID:      "164ab8",
+	Name:    "v_95",
+	Created: &Timestamp{time.Date(2017, 3, 12, 4, 56, 47, 0, time.UTC)},
+
+	IconImage:      "https://www.redditstatic.com/avatars/avatar_default_01_94E044.png",
+	SnoovatarImage: "https://i.redd.it/snoovatar/avatars/5d3f7a6c-8c3e-4c1d-9b3a-2f1e0c9d8b7a.png",
+
+	PostKarma:    488,
+	CommentKarma: 22223,
+	TotalKarma:   22711,
+
 	HasVerifiedEmail: true,
 	NSFW:             true,
+	AcceptFollowers:  true,
+
+	CanCreateSubreddit: true,
+	ShowNSFW:           true,
 }
 
 var expectedKarma = []*SubredditKarma{
@@ -154,6 +164,22 @@ func TestAccountService_Karma(t *testing.T) {
 	require.Equal(t, expectedKarma, karma)
 }
 
+func TestAccountService_Karma_Children(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/account/karma-children.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/me/karma", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	karma, _, err := client.Account.Karma(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedKarma, karma)
+}
+
 func TestAccountService_Settings(t *testing.T) {
 	client, mux := setup(t)
 
@@ -226,6 +252,36 @@ func TestAccountService_Friends(t *testing.T) {
 	require.Equal(t, expectedRelationships, relationships)
 }
 
+func TestAccountService_Friends_SingleList(t *testing.T) {
+	client, mux := setup(t)
+
+	// the friends are sometimes returned as a single user list
+	blob, err := readFileContents("../testdata/account/blocked.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/prefs/friends", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	relationships, _, err := client.Account.Friends(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedRelationships, relationships)
+}
+
+func TestAccountService_Friends_UnexpectedKind(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/prefs/friends", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{"kind": "Listing", "data": {"children": []}}`)
+	})
+
+	_, _, err := client.Account.Friends(ctx)
+	require.IsType(t, &JSONError{}, err)
+	require.Equal(t, `unexpected kind "Listing", expected "UserList"`, err.(*JSONError).Message)
+}
+
 func TestAccountService_Blocked(t *testing.T) {
 	client, mux := setup(t)
 
@@ -259,6 +315,23 @@ func TestAccountService_Messaging(t *testing.T) {
 	require.Equal(t, expectedRelationships2, trusted)
 }
 
+func TestAccountService_Messaging_BlockedOnly(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/account/blocked.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/prefs/messaging", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, "["+blob+"]")
+	})
+
+	blocked, trusted, _, err := client.Account.Messaging(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedRelationships, blocked)
+	require.Nil(t, trusted)
+}
+
 func TestAccountService_Trusted(t *testing.T) {
 	client, mux := setup(t)
 
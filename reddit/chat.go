@@ -0,0 +1,92 @@
+package reddit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// ChatService handles communication with the chat room
+// related methods of the Reddit API.
+type ChatService struct {
+	client *Client
+}
+
+// ChatRoom is a chat room attached to a subreddit.
+type ChatRoom struct {
+	ID      string     `json:"id,omitempty"`
+	Created *Timestamp `json:"created_utc,omitempty"`
+
+	Subreddit   string `json:"subreddit,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	MemberCount int  `json:"member_count"`
+	IsClosed    bool `json:"is_closed"`
+}
+
+// ChatMessage is a message sent in a chat room.
+type ChatMessage struct {
+	ID      string     `json:"id,omitempty"`
+	RoomID  string     `json:"room_id,omitempty"`
+	Created *Timestamp `json:"created_utc,omitempty"`
+
+	Author string `json:"author,omitempty"`
+	Text   string `json:"body,omitempty"`
+}
+
+// Rooms returns the chat rooms of the subreddit.
+func (s *ChatService) Rooms(ctx context.Context, subreddit string) ([]*ChatRoom, *Response, error) {
+	params := struct {
+		Subreddit string `url:"subreddit"`
+	}{subreddit}
+
+	path, err := addOptions("api/v1/chat_rooms", params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Rooms []*ChatRoom `json:"rooms"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Rooms, resp, nil
+}
+
+// CreateRoom creates a chat room in the subreddit.
+// Requires the "chat_config" moderator permission.
+func (s *ChatService) CreateRoom(ctx context.Context, subreddit, name, description string) (*ChatRoom, *Response, error) {
+	if name == "" {
+		return nil, nil, errors.New("name: cannot be empty")
+	}
+
+	form := url.Values{}
+	form.Set("subreddit", subreddit)
+	form.Set("name", name)
+	form.Set("description", description)
+
+	req, err := s.client.NewRequest(http.MethodPost, "api/v1/chat_rooms", form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Room *ChatRoom `json:"room"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Room, resp, nil
+}
@@ -0,0 +1,95 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var expectedChatRooms = []*ChatRoom{
+	{
+		ID:      "ChatRoom_7c1d2e3f",
+		Created: &Timestamp{time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+
+		Subreddit:   "testsubreddit",
+		Name:        "general",
+		Description: "Talk about anything.",
+
+		MemberCount: 42,
+	},
+	{
+		ID:      "ChatRoom_8a9b0c1d",
+		Created: &Timestamp{time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)},
+
+		Subreddit:   "testsubreddit",
+		Name:        "game-thread",
+		Description: "Live discussion during games.",
+
+		MemberCount: 7,
+		IsClosed:    true,
+	},
+}
+
+func TestChatService_Rooms(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/chat/rooms.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/chat_rooms", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("subreddit", "testsubreddit")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	rooms, _, err := client.Chat.Rooms(ctx, "testsubreddit")
+	require.NoError(t, err)
+	require.Equal(t, expectedChatRooms, rooms)
+}
+
+func TestChatService_CreateRoom(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/v1/chat_rooms", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("subreddit", "testsubreddit")
+		form.Set("name", "general")
+		form.Set("description", "Talk about anything.")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, `{
+			"room": {
+				"id": "ChatRoom_7c1d2e3f",
+				"created_utc": 1893456000,
+				"subreddit": "testsubreddit",
+				"name": "general",
+				"description": "Talk about anything.",
+				"member_count": 42,
+				"is_closed": false
+			}
+		}`)
+	})
+
+	_, _, err := client.Chat.CreateRoom(ctx, "testsubreddit", "", "")
+	require.EqualError(t, err, "name: cannot be empty")
+
+	room, _, err := client.Chat.CreateRoom(ctx, "testsubreddit", "general", "Talk about anything.")
+	require.NoError(t, err)
+	require.Equal(t, expectedChatRooms[0], room)
+}
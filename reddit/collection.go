@@ -3,9 +3,12 @@ package reddit
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -103,6 +106,37 @@ func (s *CollectionService) FromSubreddit(ctx context.Context, id string) ([]*Co
 	return collections, resp, nil
 }
 
+// RecentFromSubreddit gets the limit most recently updated collections in the subreddit,
+// ordered from most to least recently updated.
+func (s *CollectionService) RecentFromSubreddit(ctx context.Context, id string, limit int) ([]*Collection, *Response, error) {
+	if limit < 1 {
+		return nil, nil, errors.New("limit: must be at least 1")
+	}
+
+	collections, resp, err := s.FromSubreddit(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	sort.SliceStable(collections, func(i, j int) bool {
+		return collections[i].updated().After(collections[j].updated())
+	})
+
+	if len(collections) > limit {
+		collections = collections[:limit]
+	}
+
+	return collections, resp, nil
+}
+
+// updated returns the time the collection was last updated, or the zero time if unknown.
+func (c *Collection) updated() time.Time {
+	if c.Updated == nil {
+		return time.Time{}
+	}
+	return c.Updated.Time
+}
+
 // Create a collection.
 func (s *CollectionService) Create(ctx context.Context, createRequest *CollectionCreateRequest) (*Collection, *Response, error) {
 	if createRequest == nil {
@@ -161,6 +195,28 @@ func (s *CollectionService) AddPost(ctx context.Context, postID, collectionID st
 	return s.client.Do(ctx, req, nil)
 }
 
+// AddPosts adds posts (via their full IDs) to a collection (via its id), one at a time.
+// The returned slice holds the error of adding each post, in the order given, and is
+// nil for the posts that were added successfully. Adding stops early if the context is done.
+func (s *CollectionService) AddPosts(ctx context.Context, collectionID string, postIDs ...string) ([]error, error) {
+	if collectionID == "" {
+		return nil, errors.New("collectionID: cannot be empty")
+	}
+	if len(postIDs) == 0 {
+		return nil, errors.New("postIDs: must provide at least 1")
+	}
+
+	errs := make([]error, len(postIDs))
+	for i, postID := range postIDs {
+		if err := ctx.Err(); err != nil {
+			return errs, err
+		}
+		_, errs[i] = s.AddPost(ctx, postID, collectionID)
+	}
+
+	return errs, nil
+}
+
 // RemovePost removes a post (via its full ID) from a collection (via its id).
 func (s *CollectionService) RemovePost(ctx context.Context, postID, collectionID string) (*Response, error) {
 	path := "api/v1/collections/remove_post_in_collection"
@@ -178,6 +234,8 @@ func (s *CollectionService) RemovePost(ctx context.Context, postID, collectionID
 }
 
 // ReorderPosts reorders posts in a collection.
+// Any post in the collection that is not included in postIDs is removed from it.
+// Use ReorderAllPosts to guard against this.
 func (s *CollectionService) ReorderPosts(ctx context.Context, collectionID string, postIDs ...string) (*Response, error) {
 	path := "api/v1/collections/reorder_collection"
 
@@ -193,6 +251,40 @@ func (s *CollectionService) ReorderPosts(ctx context.Context, collectionID strin
 	return s.client.Do(ctx, req, nil)
 }
 
+// ReorderAllPosts reorders posts in a collection, like ReorderPosts, but first
+// checks that postIDs holds exactly the posts currently in the collection.
+// This prevents posts from being removed by accidentally leaving them out.
+func (s *CollectionService) ReorderAllPosts(ctx context.Context, collectionID string, postIDs ...string) (*Response, error) {
+	collection, resp, err := s.Get(ctx, collectionID)
+	if err != nil {
+		return resp, err
+	}
+
+	current := make(map[string]bool, len(collection.PostIDs))
+	for _, id := range collection.PostIDs {
+		current[id] = true
+	}
+
+	seen := make(map[string]bool, len(postIDs))
+	for _, id := range postIDs {
+		if !current[id] {
+			return nil, fmt.Errorf("postIDs: %s is not in the collection", id)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("postIDs: %s is provided more than once", id)
+		}
+		seen[id] = true
+	}
+
+	for _, id := range collection.PostIDs {
+		if !seen[id] {
+			return nil, fmt.Errorf("postIDs: %s is in the collection but was not provided", id)
+		}
+	}
+
+	return s.ReorderPosts(ctx, collectionID, postIDs...)
+}
+
 // UpdateTitle updates a collection's title.
 func (s *CollectionService) UpdateTitle(ctx context.Context, id string, title string) (*Response, error) {
 	path := "api/v1/collections/update_collection_title"
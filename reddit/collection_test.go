@@ -119,6 +119,35 @@ func TestCollectionService_FromSubreddit(t *testing.T) {
 	require.Equal(t, expectedCollections, collections)
 }
 
+func TestCollectionService_RecentFromSubreddit(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/v1/collections/subreddit_collections", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `[
+			{"collection_id": "middle", "last_update_utc": 1596765567.702},
+			{"collection_id": "oldest", "last_update_utc": 1596761724.19},
+			{"collection_id": "newest", "last_update_utc": 1596765572.741}
+		]`)
+	})
+
+	_, _, err := client.Collection.RecentFromSubreddit(ctx, "t5_2uquw1", 0)
+	require.EqualError(t, err, "limit: must be at least 1")
+
+	collections, _, err := client.Collection.RecentFromSubreddit(ctx, "t5_2uquw1", 5)
+	require.NoError(t, err)
+	require.Len(t, collections, 3)
+	require.Equal(t, "newest", collections[0].ID)
+	require.Equal(t, "middle", collections[1].ID)
+	require.Equal(t, "oldest", collections[2].ID)
+
+	collections, _, err = client.Collection.RecentFromSubreddit(ctx, "t5_2uquw1", 2)
+	require.NoError(t, err)
+	require.Len(t, collections, 2)
+	require.Equal(t, "newest", collections[0].ID)
+	require.Equal(t, "middle", collections[1].ID)
+}
+
 func TestCollectionService_Create(t *testing.T) {
 	client, mux := setup(t)
 
@@ -152,6 +181,52 @@ func TestCollectionService_Create(t *testing.T) {
 	require.Equal(t, expectedCollection, collection)
 }
 
+func TestCollectionService_Create_Gallery(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/collection/collection.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/collections/create_collection", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("title", "Test Title")
+		form.Set("description", "Test Description")
+		form.Set("sr_fullname", "t5_2uquw1")
+		form.Set("display_layout", "GALLERY")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Collection.Create(ctx, &CollectionCreateRequest{
+		Title:       "Test Title",
+		Description: "Test Description",
+		SubredditID: "t5_2uquw1",
+		Layout:      "GALLERY",
+	})
+	require.NoError(t, err)
+}
+
+func TestCollectionService_ReorderPosts_Single(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/v1/collections/reorder_collection", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "t3_hs0cyh", r.PostForm.Get("link_ids"))
+	})
+
+	_, err := client.Collection.ReorderPosts(ctx, "37f1e52d-7ec9-466b-b4cc-59e86e071ed7", "t3_hs0cyh")
+	require.NoError(t, err)
+}
+
 func TestCollectionService_Delete(t *testing.T) {
 	client, mux := setup(t)
 
@@ -189,6 +264,40 @@ func TestCollectionService_AddPost(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCollectionService_AddPosts(t *testing.T) {
+	client, mux := setup(t)
+
+	var added []string
+	mux.HandleFunc("/api/v1/collections/add_post_to_collection", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "37f1e52d-7ec9-466b-b4cc-59e86e071ed7", r.PostForm.Get("collection_id"))
+
+		postID := r.PostForm.Get("link_fullname")
+		added = append(added, postID)
+		if postID == "t3_invalid" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"explanation": "that post does not exist", "reason": "INVALID_LINK"}`)
+		}
+	})
+
+	_, err := client.Collection.AddPosts(ctx, "", "t3_hs0cyh")
+	require.EqualError(t, err, "collectionID: cannot be empty")
+
+	_, err = client.Collection.AddPosts(ctx, "37f1e52d-7ec9-466b-b4cc-59e86e071ed7")
+	require.EqualError(t, err, "postIDs: must provide at least 1")
+
+	errs, err := client.Collection.AddPosts(ctx, "37f1e52d-7ec9-466b-b4cc-59e86e071ed7", "t3_hs0cyh", "t3_invalid", "t3_hs03f3")
+	require.NoError(t, err)
+	require.Equal(t, []string{"t3_hs0cyh", "t3_invalid", "t3_hs03f3"}, added)
+	require.Len(t, errs, 3)
+	require.NoError(t, errs[0])
+	require.Error(t, errs[1])
+	require.NoError(t, errs[2])
+}
+
 func TestCollectionService_RemovePost(t *testing.T) {
 	client, mux := setup(t)
 
@@ -227,6 +336,46 @@ func TestCollectionService_ReorderPosts(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCollectionService_ReorderAllPosts(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/collection/collection.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/collections/collection", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	reordered := false
+	mux.HandleFunc("/api/v1/collections/reorder_collection", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("collection_id", "37f1e52d-7ec9-466b-b4cc-59e86e071ed7")
+		form.Set("link_ids", "t3_hs03f3,t3_hs0cyh,t3_hqrg8s")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+		reordered = true
+	})
+
+	_, err = client.Collection.ReorderAllPosts(ctx, "37f1e52d-7ec9-466b-b4cc-59e86e071ed7", "t3_hs0cyh", "t3_hqrg8s")
+	require.EqualError(t, err, "postIDs: t3_hs03f3 is in the collection but was not provided")
+
+	_, err = client.Collection.ReorderAllPosts(ctx, "37f1e52d-7ec9-466b-b4cc-59e86e071ed7", "t3_hs0cyh", "t3_hqrg8s", "t3_hs03f3", "t3_test")
+	require.EqualError(t, err, "postIDs: t3_test is not in the collection")
+
+	_, err = client.Collection.ReorderAllPosts(ctx, "37f1e52d-7ec9-466b-b4cc-59e86e071ed7", "t3_hs0cyh", "t3_hs0cyh", "t3_hqrg8s")
+	require.EqualError(t, err, "postIDs: t3_hs0cyh is provided more than once")
+	require.False(t, reordered)
+
+	_, err = client.Collection.ReorderAllPosts(ctx, "37f1e52d-7ec9-466b-b4cc-59e86e071ed7", "t3_hs03f3", "t3_hs0cyh", "t3_hqrg8s")
+	require.NoError(t, err)
+	require.True(t, reordered)
+}
+
 func TestCollectionService_UpdateTitle(t *testing.T) {
 	client, mux := setup(t)
 
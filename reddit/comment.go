@@ -2,10 +2,14 @@ package reddit
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
+
+	"github.com/google/go-querystring/query"
 )
 
 // CommentService handles communication with the comment
@@ -17,16 +21,51 @@ type CommentService struct {
 	client *Client
 }
 
+// SubmitCommentRequest are options used when submitting a comment.
+// Exactly one of Text or RichtextJSON must be set.
+type SubmitCommentRequest struct {
+	// Text is the markdown body of the comment.
+	Text string `url:"text,omitempty"`
+	// RichtextJSON is the body of the comment in Reddit's richtext JSON format.
+	RichtextJSON string `url:"richtext_json,omitempty"`
+}
+
+func (r *SubmitCommentRequest) validate() error {
+	if r.Text != "" && r.RichtextJSON != "" {
+		return errors.New("only one of Text or RichtextJSON may be set")
+	}
+	if r.Text == "" && r.RichtextJSON == "" {
+		return errors.New("one of Text or RichtextJSON must be set")
+	}
+	if r.RichtextJSON != "" && !json.Valid([]byte(r.RichtextJSON)) {
+		return errors.New("RichtextJSON: must be valid JSON")
+	}
+	return nil
+}
+
 // Submit a comment as a reply to a post, comment, or message.
 // parentID is the full ID of the thing being replied to.
 func (s *CommentService) Submit(ctx context.Context, parentID string, text string) (*Comment, *Response, error) {
+	return s.SubmitWithOptions(ctx, parentID, SubmitCommentRequest{Text: text})
+}
+
+// SubmitWithOptions submits a comment as a reply to a post, comment, or message,
+// with its body given as either markdown text or richtext JSON.
+// parentID is the full ID of the thing being replied to.
+func (s *CommentService) SubmitWithOptions(ctx context.Context, parentID string, opts SubmitCommentRequest) (*Comment, *Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	path := "api/comment"
 
-	form := url.Values{}
+	form, err := query.Values(opts)
+	if err != nil {
+		return nil, nil, err
+	}
 	form.Set("api_type", "json")
 	form.Set("return_rtjson", "true")
 	form.Set("parent", parentID)
-	form.Set("text", text)
 
 	req, err := s.client.NewRequest(http.MethodPost, path, form)
 	if err != nil {
@@ -66,6 +105,43 @@ func (s *CommentService) Edit(ctx context.Context, id string, text string) (*Com
 	return root, resp, nil
 }
 
+// GetWithContext gets a comment along with the post it's in and up to 8 of its parent comments.
+// postID and commentID are ID36s, not full ids. The returned post's comment tree starts at
+// the highest parent that was included, and the returned comment is the one that was asked for.
+func (s *CommentService) GetWithContext(ctx context.Context, postID, commentID string, parents int) (*PostAndComments, *Comment, *Response, error) {
+	if commentID == "" {
+		return nil, nil, nil, errors.New("commentID: cannot be empty")
+	}
+
+	postAndComments, resp, err := s.client.Post.GetWithOptions(ctx, postID, &ListPostCommentsOptions{
+		Comment: commentID,
+		Context: parents,
+	})
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	comment := findComment(postAndComments.Comments, commentID)
+	if comment == nil {
+		return nil, nil, resp, fmt.Errorf("comment %s was not returned by Reddit", commentID)
+	}
+
+	return postAndComments, comment, resp, nil
+}
+
+// findComment searches the comment trees for the comment with the id.
+func findComment(comments []*Comment, id string) *Comment {
+	for _, c := range comments {
+		if c.ID == id {
+			return c
+		}
+		if found := findComment(c.Replies.Comments, id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
 // LoadMoreReplies retrieves more replies that were left out when initially fetching the comment.
 func (s *CommentService) LoadMoreReplies(ctx context.Context, comment *Comment) (*Response, error) {
 	if comment == nil {
@@ -22,6 +22,11 @@ var expectedCommentSubmitOrEdit = &Comment{
 	AuthorFlairText: "Flair",
 	AuthorFlairID:   "024b2b66-05ca-11e1-96f4-12313d096aae",
 
+	AuthorFlairTextColor: "dark",
+	AuthorFlairRichtext: []map[string]string{
+		{"e": "text", "t": "Beginner - Strength"},
+	},
+
 	SubredditName:         "subreddit",
 	SubredditNamePrefixed: "r/subreddit",
 	SubredditID:           "t5_test",
@@ -31,6 +36,8 @@ var expectedCommentSubmitOrEdit = &Comment{
 	Score:            1,
 	Controversiality: 0,
 
+	AllAwardings: []*Awarding{},
+
 	Created: &Timestamp{time.Date(2020, 4, 29, 0, 9, 47, 0, time.UTC)},
 	Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
 
@@ -64,6 +71,51 @@ func TestCommentService_Submit(t *testing.T) {
 	require.Equal(t, expectedCommentSubmitOrEdit, comment)
 }
 
+func TestCommentService_SubmitWithOptions(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/comment/submit-or-edit.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/comment", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("return_rtjson", "true")
+		form.Set("parent", "t1_test")
+		form.Set("richtext_json", `{"document":[]}`)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	comment, _, err := client.Comment.SubmitWithOptions(ctx, "t1_test", SubmitCommentRequest{
+		RichtextJSON: `{"document":[]}`,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedCommentSubmitOrEdit, comment)
+}
+
+func TestCommentService_SubmitWithOptions_Invalid(t *testing.T) {
+	client, _ := setup(t)
+
+	_, _, err := client.Comment.SubmitWithOptions(ctx, "t1_test", SubmitCommentRequest{
+		Text:         "test comment",
+		RichtextJSON: `{"document":[]}`,
+	})
+	require.EqualError(t, err, "only one of Text or RichtextJSON may be set")
+
+	_, _, err = client.Comment.SubmitWithOptions(ctx, "t1_test", SubmitCommentRequest{})
+	require.EqualError(t, err, "one of Text or RichtextJSON must be set")
+
+	_, _, err = client.Comment.SubmitWithOptions(ctx, "t1_test", SubmitCommentRequest{RichtextJSON: "{"})
+	require.EqualError(t, err, "RichtextJSON: must be valid JSON")
+}
+
 func TestCommentService_Edit(t *testing.T) {
 	client, mux := setup(t)
 
@@ -91,6 +143,41 @@ func TestCommentService_Edit(t *testing.T) {
 	require.Equal(t, expectedCommentSubmitOrEdit, comment)
 }
 
+func TestCommentService_GetWithContext(t *testing.T) {
+	client, mux := setup(t)
+
+	// contains comment testc2 along with its parent, testc1
+	blob, err := readFileContents("../testdata/post/post.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/comments/testpost", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "1", r.Form.Get("context"))
+		require.Contains(t, []string{"testc2", "testc3"}, r.Form.Get("comment"))
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, _, err = client.Comment.GetWithContext(ctx, "testpost", "", 1)
+	require.EqualError(t, err, "commentID: cannot be empty")
+
+	_, _, _, err = client.Comment.GetWithContext(ctx, "testpost", "testc2", 9)
+	require.EqualError(t, err, "(*ListPostCommentsOptions).Context: 9 is not between 0 and 8")
+
+	_, _, _, err = client.Comment.GetWithContext(ctx, "testpost", "testc3", 1)
+	require.EqualError(t, err, "comment testc3 was not returned by Reddit")
+
+	postAndComments, comment, _, err := client.Comment.GetWithContext(ctx, "testpost", "testc2", 1)
+	require.NoError(t, err)
+	require.Equal(t, expectedPostAndComments.Post.Title, postAndComments.Post.Title)
+	require.Equal(t, "Hi", postAndComments.Comments[0].Body)
+	require.Equal(t, "Hello", comment.Body)
+	require.Equal(t, "t1_testc1", comment.ParentID)
+}
+
 func TestCommentService_Delete(t *testing.T) {
 	client, mux := setup(t)
 
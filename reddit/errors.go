@@ -35,6 +35,26 @@ func (e *APIError) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// JSONError occurs when JSON data coming from or going to Reddit is invalid.
+type JSONError struct {
+	// Error message
+	Message string
+	// The invalid JSON data, if available
+	Data []byte
+
+	// Status code of the HTTP response that contained the data, if any
+	StatusCode int
+	// URL of the request that returned the data, if any
+	URL string
+}
+
+func (e *JSONError) Error() string {
+	if e.URL == "" {
+		return fmt.Sprintf("invalid JSON: %s", e.Message)
+	}
+	return fmt.Sprintf("invalid JSON from %s: %d %s", e.URL, e.StatusCode, e.Message)
+}
+
 // JSONErrorResponse is an error response that sometimes gets returned with a 200 code.
 type JSONErrorResponse struct {
 	// HTTP response that caused this error.
@@ -64,6 +84,11 @@ type ErrorResponse struct {
 
 	// Error message
 	Message string `json:"message"`
+
+	// The first bytes of the response body, since the body itself is closed
+	// by the time the error is returned. Useful when the body isn't JSON,
+	// e.g. an HTML error page.
+	BodySnippet string `json:"-"`
 }
 
 func (r *ErrorResponse) Error() string {
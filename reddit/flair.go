@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/google/go-querystring/query"
 )
@@ -122,6 +123,9 @@ type FlairSelectRequest struct {
 	Text string `url:"text,omitempty"`
 }
 
+// The maximum number of flair changes Reddit accepts in a single request.
+const maxFlairChangeRequests = 100
+
 // FlairChangeRequest represents a request to change a user's flair.
 // If Text and CSSClass are empty, the request will just clear the user's flair.
 type FlairChangeRequest struct {
@@ -195,6 +199,36 @@ func (s *FlairService) ListUserFlairs(ctx context.Context, subreddit string) ([]
 	return root.UserFlairs, resp, nil
 }
 
+// UserFlairOf returns the flair of the user in the subreddit.
+func (s *FlairService) UserFlairOf(ctx context.Context, subreddit, username string) (*FlairSummary, *Response, error) {
+	if username == "" {
+		return nil, nil, errors.New("username: cannot be empty")
+	}
+
+	path := fmt.Sprintf("r/%s/api/flairlist?name=%s&limit=1", subreddit, url.QueryEscape(username))
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		UserFlairs []*FlairSummary `json:"users"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, flair := range root.UserFlairs {
+		if strings.EqualFold(flair.User, username) {
+			return flair, resp, nil
+		}
+	}
+
+	return nil, resp, fmt.Errorf("flair of user %s not found", username)
+}
+
 // Configure the subreddit's flair settings.
 func (s *FlairService) Configure(ctx context.Context, subreddit string, request *FlairConfigureRequest) (*Response, error) {
 	if request == nil {
@@ -396,11 +430,13 @@ func (s *FlairService) ReorderPostTemplates(ctx context.Context, subreddit strin
 }
 
 // Choices returns a list of flairs you can assign to yourself in the subreddit, and your current one.
+// If you don't have a flair in the subreddit, the current one is nil.
 func (s *FlairService) Choices(ctx context.Context, subreddit string) ([]*FlairChoice, *FlairChoice, *Response, error) {
 	return s.ChoicesOf(ctx, subreddit, s.client.Username)
 }
 
 // ChoicesOf returns a list of flairs the user can assign to themself in the subreddit, and their current one.
+// If the user doesn't have a flair in the subreddit, the current one is nil.
 // Unless the user is you, this only works if you're a moderator of the subreddit.
 func (s *FlairService) ChoicesOf(ctx context.Context, subreddit, username string) ([]*FlairChoice, *FlairChoice, *Response, error) {
 	path := fmt.Sprintf("r/%s/api/flairselector", subreddit)
@@ -444,6 +480,11 @@ func (s *FlairService) choices(ctx context.Context, path string, form url.Values
 		return nil, nil, resp, err
 	}
 
+	// When there's no flair assigned, Reddit still sends a current flair, just with its fields nulled out.
+	if root.Current != nil && root.Current.TemplateID == "" && root.Current.Text == "" {
+		root.Current = nil
+	}
+
 	return root.Choices, root.Current, resp, nil
 }
 
@@ -520,8 +561,13 @@ func (s *FlairService) RemoveFromPost(ctx context.Context, postID string) (*Resp
 // Change the flair of multiple users in the subreddit at once.
 // You have to be a moderator of the subreddit for this to work.
 func (s *FlairService) Change(ctx context.Context, subreddit string, requests []FlairChangeRequest) ([]*FlairChangeResponse, *Response, error) {
-	if len(requests) == 0 || len(requests) > 100 {
-		return nil, nil, errors.New("requests: must provide between 1 and 100")
+	if len(requests) == 0 || len(requests) > maxFlairChangeRequests {
+		return nil, nil, fmt.Errorf("requests: must provide between 1 and %d", maxFlairChangeRequests)
+	}
+	for i, req := range requests {
+		if req.User == "" {
+			return nil, nil, fmt.Errorf("requests[%d].User: cannot be empty", i)
+		}
 	}
 
 	records := make([][]string, len(requests))
@@ -560,3 +606,33 @@ func (s *FlairService) Change(ctx context.Context, subreddit string, requests []
 
 	return root, resp, nil
 }
+
+// ChangeAll changes the flair of any number of users in the subreddit, splitting
+// the requests into batches of at most 100 as required by Change.
+// The responses of every batch are returned together, in the order of the requests.
+func (s *FlairService) ChangeAll(ctx context.Context, subreddit string, requests []FlairChangeRequest) ([]*FlairChangeResponse, *Response, error) {
+	if len(requests) == 0 {
+		return nil, nil, errors.New("requests: must provide at least 1")
+	}
+
+	var results []*FlairChangeResponse
+	var resp *Response
+
+	for start := 0; start < len(requests); start += maxFlairChangeRequests {
+		end := start + maxFlairChangeRequests
+		if end > len(requests) {
+			end = len(requests)
+		}
+
+		var batch []*FlairChangeResponse
+		var err error
+
+		batch, resp, err = s.Change(ctx, subreddit, requests[start:end])
+		if err != nil {
+			return nil, resp, err
+		}
+		results = append(results, batch...)
+	}
+
+	return results, resp, nil
+}
@@ -1,10 +1,12 @@
 package reddit
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -199,6 +201,51 @@ func TestFlairService_ListUserFlairs(t *testing.T) {
 	require.Equal(t, expectedListUserFlairs, userFlairs)
 }
 
+func TestFlairService_UserFlairOf(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/flairlist", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("name", "testuser1")
+		form.Set("limit", "1")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, `{
+			"users": [
+				{
+					"flair_css_class": "testclass",
+					"user": "TestUser1",
+					"flair_text": "TestFlair1"
+				}
+			]
+		}`)
+	})
+
+	_, _, err := client.Flair.UserFlairOf(ctx, "testsubreddit", "")
+	require.EqualError(t, err, "username: cannot be empty")
+
+	userFlair, _, err := client.Flair.UserFlairOf(ctx, "testsubreddit", "testuser1")
+	require.NoError(t, err)
+	require.Equal(t, &FlairSummary{User: "TestUser1", Text: "TestFlair1", CSSClass: "testclass"}, userFlair)
+}
+
+func TestFlairService_UserFlairOf_NotFound(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/flairlist", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{"users": []}`)
+	})
+
+	_, _, err := client.Flair.UserFlairOf(ctx, "testsubreddit", "testuser1")
+	require.EqualError(t, err, "flair of user testuser1 not found")
+}
+
 func TestFlairService_Configure(t *testing.T) {
 	client, mux := setup(t)
 
@@ -517,6 +564,38 @@ func TestFlairService_ChoicesOf(t *testing.T) {
 	require.Equal(t, expectedFlairChoice, current)
 }
 
+func TestFlairService_ChoicesOf_NoCurrent(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/flair/choices-no-current.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/api/flairselector", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	choices, current, _, err := client.Flair.ChoicesOf(ctx, "testsubreddit", "testuser")
+	require.NoError(t, err)
+	require.Nil(t, current)
+	require.Equal(t, []*FlairChoice{
+		{
+			TemplateID: "c4edd5ce-40e8-11e7-b814-0ef91bd65558",
+			Text:       "Reddit API",
+			Editable:   false,
+			Position:   "left",
+			CSSClass:   "",
+		},
+		{
+			TemplateID: "49bb3d06-0dad-11e7-b897-0e42c2400b7a",
+			Text:       "PRAW",
+			Editable:   true,
+			Position:   "left",
+			CSSClass:   "",
+		},
+	}, choices)
+}
+
 func TestFlairService_ChoicesForPost(t *testing.T) {
 	client, mux := setup(t)
 
@@ -645,6 +724,27 @@ func TestFlairService_SelectForPost(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestFlairService_SelectForPost_Error(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/selectflair", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		fmt.Fprint(w, `{
+			"json": {
+				"errors": [
+					["BAD_FLAIR_TEMPLATE_ID", "that flair template id is invalid", "flair_template_id"]
+				]
+			}
+		}`)
+	})
+
+	_, err := client.Flair.SelectForPost(ctx, "t3_123", &FlairSelectRequest{
+		ID: "invalid",
+	})
+	require.IsType(t, &JSONErrorResponse{}, err)
+	require.Equal(t, []APIError{{Label: "BAD_FLAIR_TEMPLATE_ID", Reason: "that flair template id is invalid", Field: "flair_template_id"}}, err.(*JSONErrorResponse).JSON.Errors)
+}
+
 func TestFlairService_RemoveFromPost(t *testing.T) {
 	client, mux := setup(t)
 
@@ -690,6 +790,9 @@ testuser4,testtext4,testclass4
 	_, _, err = client.Flair.Change(ctx, "testsubreddit", nil)
 	require.EqualError(t, err, "requests: must provide between 1 and 100")
 
+	_, _, err = client.Flair.Change(ctx, "testsubreddit", make([]FlairChangeRequest, 101))
+	require.EqualError(t, err, "requests: must provide between 1 and 100")
+
 	changes, _, err := client.Flair.Change(ctx, "testsubreddit", []FlairChangeRequest{
 		{"testuser1", "testtext1", "testclass1"},
 		{"testuser2", "testtext2", "testclass2"},
@@ -699,3 +802,77 @@ testuser4,testtext4,testclass4
 	require.NoError(t, err)
 	require.Equal(t, expectedFlairChanges, changes)
 }
+
+func TestFlairService_Change_CSVFormat(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/flaircsv", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		// one "user,text,class" line per user, with fields quoted when needed
+		form := url.Values{}
+		form.Set("flair_csv", "user,text,class\n"+
+			"testuser2,\"text, with a comma\",\n"+
+			"testuser3,\"text with \"\"quotes\"\"\",testclass3\n")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, `[]`)
+	})
+
+	_, _, err := client.Flair.Change(ctx, "testsubreddit", []FlairChangeRequest{
+		{"user", "text", "class"},
+		{"testuser2", "text, with a comma", ""},
+		{"testuser3", `text with "quotes"`, "testclass3"},
+	})
+	require.NoError(t, err)
+}
+
+func TestFlairService_Change_EmptyUser(t *testing.T) {
+	client, _ := setup(t)
+
+	_, _, err := client.Flair.Change(ctx, "testsubreddit", []FlairChangeRequest{
+		{"testuser1", "testtext1", ""},
+		{"", "testtext2", ""},
+	})
+	require.EqualError(t, err, "requests[1].User: cannot be empty")
+}
+
+func TestFlairService_ChangeAll(t *testing.T) {
+	client, mux := setup(t)
+
+	var batchSizes []int
+	mux.HandleFunc("/r/testsubreddit/api/flaircsv", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		records, err := csv.NewReader(strings.NewReader(r.PostForm.Get("flair_csv"))).ReadAll()
+		require.NoError(t, err)
+		batchSizes = append(batchSizes, len(records))
+
+		responses := make([]string, len(records))
+		for i, record := range records {
+			responses[i] = fmt.Sprintf(`{"ok": true, "status": "added flair for user %s"}`, record[0])
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(responses, ","))
+	})
+
+	_, _, err := client.Flair.ChangeAll(ctx, "testsubreddit", nil)
+	require.EqualError(t, err, "requests: must provide at least 1")
+
+	requests := make([]FlairChangeRequest, 150)
+	for i := range requests {
+		requests[i] = FlairChangeRequest{User: fmt.Sprintf("testuser%d", i), Text: "testtext"}
+	}
+
+	changes, _, err := client.Flair.ChangeAll(ctx, "testsubreddit", requests)
+	require.NoError(t, err)
+	require.Equal(t, []int{100, 50}, batchSizes)
+	require.Len(t, changes, 150)
+	require.Equal(t, "added flair for user testuser0", changes[0].Status)
+	require.Equal(t, "added flair for user testuser149", changes[149].Status)
+}
@@ -17,6 +17,23 @@ type GoldService struct {
 	client *Client
 }
 
+// Award is an award that can be given to a post or comment.
+type Award struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	// One of: global, community, moderator.
+	Type      string `json:"award_type,omitempty"`
+	CoinPrice int    `json:"coin_price"`
+	IconURL   string `json:"icon_url,omitempty"`
+}
+
+// Awarding is an award given to a post or comment, along with the number of times it was given.
+type Awarding struct {
+	Award
+	Count int `json:"count"`
+}
+
 // Gild the post or comment via its full ID.
 // This requires you to own Reddit coins and will consume them.
 func (s *GoldService) Gild(ctx context.Context, id string) (*Response, error) {
@@ -47,3 +64,52 @@ func (s *GoldService) Give(ctx context.Context, username string, months int) (*R
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// Awards returns the awards that can be given to the post or comment via its full ID.
+func (s *GoldService) Awards(ctx context.Context, id string) ([]*Award, *Response, error) {
+	if id == "" {
+		return nil, nil, errors.New("id: cannot be empty")
+	}
+
+	path := fmt.Sprintf("api/v1/links/%s/awards", id)
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Awards []*Award `json:"awards"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Awards, resp, nil
+}
+
+// GiveAward gives the award to the post or comment via its full ID.
+// Use Awards to get the IDs of the awards that can be given.
+// This requires you to own Reddit coins and will consume them.
+func (s *GoldService) GiveAward(ctx context.Context, id string, awardID string, anonymous bool) (*Response, error) {
+	if id == "" {
+		return nil, errors.New("id: cannot be empty")
+	}
+	if awardID == "" {
+		return nil, errors.New("awardID: cannot be empty")
+	}
+
+	path := "api/v2/gold/gild"
+
+	form := url.Values{}
+	form.Set("thing_id", id)
+	form.Set("gild_type", awardID)
+	form.Set("is_anonymous", strconv.FormatBool(anonymous))
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -1,6 +1,7 @@
 package reddit
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
 	"testing"
@@ -42,3 +43,65 @@ func TestGoldService_Give(t *testing.T) {
 	_, err = client.Gold.Give(ctx, "testuser", 1)
 	require.NoError(t, err)
 }
+
+func TestGoldService_Awards(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/gold/awards.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/links/t3_test/awards", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Gold.Awards(ctx, "")
+	require.EqualError(t, err, "id: cannot be empty")
+
+	awards, _, err := client.Gold.Awards(ctx, "t3_test")
+	require.NoError(t, err)
+	require.Equal(t, []*Award{
+		{
+			ID:          "gid_1",
+			Name:        "Silver",
+			Description: "Shows the Silver Award... and that's it.",
+			Type:        "global",
+			CoinPrice:   100,
+			IconURL:     "https://www.redditstatic.com/gold/awards/icon/silver_512.png",
+		},
+		{
+			ID:          "award_5f123e3d-4f48-42f4-9c11-e98b566d5897",
+			Name:        "Wholesome",
+			Description: "When you come across a feel-good thing.",
+			Type:        "global",
+			CoinPrice:   150,
+			IconURL:     "https://i.redd.it/award_images/t5_22cerq/5izbv4fn0md41_Wholesome.png",
+		},
+	}, awards)
+}
+
+func TestGoldService_GiveAward(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/v2/gold/gild", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("thing_id", "t3_test")
+		form.Set("gild_type", "gid_1")
+		form.Set("is_anonymous", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Gold.GiveAward(ctx, "", "gid_1", true)
+	require.EqualError(t, err, "id: cannot be empty")
+
+	_, err = client.Gold.GiveAward(ctx, "t3_test", "", true)
+	require.EqualError(t, err, "awardID: cannot be empty")
+
+	_, err = client.Gold.GiveAward(ctx, "t3_test", "gid_1", true)
+	require.NoError(t, err)
+}
@@ -2,6 +2,7 @@ package reddit
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -29,6 +30,70 @@ func (s *ListingsService) Get(ctx context.Context, ids ...string) ([]*Post, []*C
 	return l.Posts(), l.Comments(), l.Subreddits(), resp, nil
 }
 
+// The max number of full IDs that can be requested at once from api/info.
+const maxInfoIDs = 100
+
+// GetByFullnames gets posts, comments, and subreddits from their full IDs.
+// Unlike Listings.Get, the full IDs are validated before making any request, and they
+// are requested in batches of 100, the max allowed by Reddit.
+// The returned response is the one of the last batch.
+func (c *Client) GetByFullnames(ctx context.Context, fullnames ...string) ([]*Post, []*Comment, []*Subreddit, *Response, error) {
+	if len(fullnames) == 0 {
+		return nil, nil, nil, nil, errors.New("fullnames: must provide at least 1")
+	}
+	for _, fullname := range fullnames {
+		if !isInfoFullname(fullname) {
+			return nil, nil, nil, nil, fmt.Errorf("fullname %q: must be the full ID of a post, comment, or subreddit", fullname)
+		}
+	}
+
+	var posts []*Post
+	var comments []*Comment
+	var subreddits []*Subreddit
+	var resp *Response
+
+	for start := 0; start < len(fullnames); start += maxInfoIDs {
+		end := start + maxInfoIDs
+		if end > len(fullnames) {
+			end = len(fullnames)
+		}
+
+		batchPosts, batchComments, batchSubreddits, batchResp, err := c.Listings.Get(ctx, fullnames[start:end]...)
+		resp = batchResp
+		if err != nil {
+			return nil, nil, nil, resp, err
+		}
+
+		posts = append(posts, batchPosts...)
+		comments = append(comments, batchComments...)
+		subreddits = append(subreddits, batchSubreddits...)
+	}
+
+	return posts, comments, subreddits, resp, nil
+}
+
+// isInfoFullname reports whether the full ID is one of a thing returned by api/info.
+func isInfoFullname(fullname string) bool {
+	i := strings.Index(fullname, "_")
+	if i == -1 || i == len(fullname)-1 {
+		return false
+	}
+
+	switch fullname[:i] {
+	case kindComment, kindPost, kindSubreddit:
+	default:
+		return false
+	}
+
+	// the id part is in base 36
+	for _, r := range fullname[i+1:] {
+		if !('0' <= r && r <= '9' || 'a' <= r && r <= 'z') {
+			return false
+		}
+	}
+	return true
+}
+
 // GetPosts returns posts from their full IDs.
 func (s *ListingsService) GetPosts(ctx context.Context, ids ...string) ([]*Post, *Response, error) {
 	path := fmt.Sprintf("by_id/%s", strings.Join(ids, ","))
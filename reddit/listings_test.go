@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -37,6 +38,11 @@ var expectedListingPosts = []*Post{
 		AuthorID: "t2_164ab8",
 
 		IsSelfPost: true,
+
+		Thumbnail:          "self",
+		LinkFlairTextColor: "dark",
+		IsCrosspostable:    true,
+		AllAwardings:       []*Awarding{},
 	},
 }
 
@@ -63,9 +69,13 @@ var expectedListingComments = []*Comment{
 		Score:            1,
 		Controversiality: 0,
 
+		AllAwardings: []*Awarding{},
+
 		PostID: "t3_i2gvg4",
 
 		IsSubmitter: true,
+
+		AuthorFlairRichtext: []map[string]string{},
 	},
 }
 
@@ -81,6 +91,16 @@ var expectedListingSubreddits = []*Subreddit{
 		Title:        "Testing",
 		Type:         "public",
 
+		SubmissionType: "any",
+		Language:       "en",
+
+		AllowImages:     true,
+		AllowVideos:     true,
+		AllowVideoGIFs:  true,
+		AllowGalleries:  true,
+		AllowPolls:      true,
+		SpoilersEnabled: true,
+
 		Subscribers: 8202,
 		Subscribed:  true,
 	},
@@ -113,6 +133,11 @@ var expectedListingPosts2 = []*Post{
 		AuthorID: "t2_164ab8",
 
 		IsSelfPost: true,
+
+		Thumbnail:          "self",
+		LinkFlairTextColor: "dark",
+		IsCrosspostable:    true,
+		AllAwardings:       []*Awarding{},
 	},
 	{
 		ID:      "i2gvs1",
@@ -137,6 +162,11 @@ var expectedListingPosts2 = []*Post{
 
 		Author:   "v_95",
 		AuthorID: "t2_164ab8",
+
+		Thumbnail:          "default",
+		LinkFlairTextColor: "dark",
+		IsCrosspostable:    true,
+		AllAwardings:       []*Awarding{},
 	},
 }
 
@@ -166,6 +196,119 @@ func TestListingsService_Get(t *testing.T) {
 	require.Equal(t, expectedListingSubreddits, subreddits)
 }
 
+func TestListingsService_Get_DistinguishedComment(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/listings/comments-distinguished.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, comments, _, _, err := client.Listings.Get(ctx, "t1_g6w2r5t")
+	require.NoError(t, err)
+	require.Equal(t, []*Comment{
+		{
+			ID:      "g6w2r5t",
+			FullID:  "t1_g6w2r5t",
+			Created: &Timestamp{time.Date(2020, 9, 29, 3, 20, 0, 0, time.UTC)},
+			Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+
+			ParentID:  "t3_j0nd8k",
+			Permalink: "/r/test/comments/j0nd8k/crossposted_video/g6w2r5t/",
+
+			Body:            "Please keep the discussion on topic.",
+			Author:          "test_mod",
+			AuthorID:        "t2_testmod",
+			AuthorFlairText: ":mod: Moderator",
+			AuthorFlairID:   "a1c5f7d0-0e4c-11eb-9d4a-0e8d3a6ccf3b",
+
+			AuthorFlairBackgroundColor: "#373c3f",
+			AuthorFlairTextColor:       "light",
+			AuthorFlairRichtext: []map[string]string{
+				{"a": ":mod:", "e": "emoji", "u": "https://emoji.redditmedia.com/mod.png"},
+				{"e": "text", "t": "Moderator"},
+			},
+
+			Distinguished: "moderator",
+
+			SubredditName:         "test",
+			SubredditNamePrefixed: "r/test",
+			SubredditID:           "t5_2qh23",
+
+			Score:               15,
+			Controversiality:    1,
+			TotalAwardsReceived: 2,
+
+			AllAwardings: []*Awarding{
+				{
+					Award: Award{
+						ID:          "award_f44611f1-b89e-46dc-97fe-892280b13b82",
+						Name:        "Helpful",
+						Description: "Thank you stranger. Shows the award.",
+						Type:        "global",
+						CoinPrice:   150,
+						IconURL:     "https://i.redd.it/award_images/t5_22cerq/klvxk1wggfd41_Helpful.png",
+					},
+					Count: 2,
+				},
+			},
+
+			PostID: "t3_j0nd8k",
+
+			ScoreHidden: true,
+			Stickied:    true,
+			CanGild:     true,
+
+			Collapsed:       true,
+			CollapsedReason: "comment score below threshold",
+		},
+	}, comments)
+}
+
+func TestClient_GetByFullnames(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/listings/posts-comments-subreddits.json")
+	require.NoError(t, err)
+
+	fullnames := make([]string, 0, 150)
+	for i := 0; i < 50; i++ {
+		fullnames = append(fullnames, fmt.Sprintf("t5_%d", i), fmt.Sprintf("t3_%d", i), fmt.Sprintf("t1_%d", i))
+	}
+
+	var batches [][]string
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		batches = append(batches, strings.Split(r.Form.Get("id"), ","))
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, _, _, err = client.GetByFullnames(ctx)
+	require.EqualError(t, err, "fullnames: must provide at least 1")
+
+	for _, fullname := range []string{"", "t3", "t3_", "t2_test", "t3_ABC", "abc"} {
+		_, _, _, _, err = client.GetByFullnames(ctx, "t3_test", fullname)
+		require.EqualError(t, err, fmt.Sprintf("fullname %q: must be the full ID of a post, comment, or subreddit", fullname))
+	}
+	require.Empty(t, batches)
+
+	posts, comments, subreddits, _, err := client.GetByFullnames(ctx, fullnames...)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{fullnames[:100], fullnames[100:]}, batches)
+
+	// each batch returns the same fixture
+	require.Equal(t, append(expectedListingPosts, expectedListingPosts...), posts)
+	require.Equal(t, append(expectedListingComments, expectedListingComments...), comments)
+	require.Equal(t, append(expectedListingSubreddits, expectedListingSubreddits...), subreddits)
+}
+
 func TestListingsService_GetPosts(t *testing.T) {
 	client, mux := setup(t)
 
@@ -181,3 +324,64 @@ func TestListingsService_GetPosts(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedListingPosts2, posts)
 }
+
+func TestListingsService_GetPosts_Crosspost(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/listings/posts-crosspost.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/by_id/t3_j0nd8k", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	posts, _, err := client.Listings.GetPosts(ctx, "t3_j0nd8k")
+	require.NoError(t, err)
+	require.Equal(t, []*Post{
+		{
+			ID:      "j0nd8k",
+			FullID:  "t3_j0nd8k",
+			Created: &Timestamp{time.Date(2020, 9, 29, 2, 20, 0, 0, time.UTC)},
+			Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+
+			Permalink:       "/r/test/comments/j0nd8k/crossposted_video/",
+			URL:             "https://v.redd.it/6r7ka5yvbnp51",
+			Thumbnail:       "https://b.thumbs.redditmedia.com/y5Jf0GhWKyHMtbUqvEs4gT6D1V6EqvS_-Q4vO0gQnoQ.jpg",
+			ThumbnailWidth:  140,
+			ThumbnailHeight: 78,
+			PostHint:        "hosted:video",
+
+			Title: "Crossposted video",
+
+			LinkFlairID:              "8e3f1b02-0e4c-11eb-9d4a-0e8d3a6ccf3b",
+			LinkFlairText:            "Meta",
+			LinkFlairBackgroundColor: "#ff4500",
+			LinkFlairTextColor:       "light",
+
+			SuggestedSort: "new",
+
+			Score:               42,
+			UpvoteRatio:         0.98,
+			NumberOfComments:    3,
+			TotalAwardsReceived: 1,
+
+			CrosspostParent: "t3_izzx1m",
+
+			SubredditName:         "test",
+			SubredditNamePrefixed: "r/test",
+			SubredditID:           "t5_2qh23",
+			SubredditSubscribers:  8278,
+
+			Author:   "v_95",
+			AuthorID: "t2_164ab8",
+
+			Pinned:  true,
+			IsVideo: true,
+			IsMeta:  true,
+
+			IsCrosspostable: true,
+			AllAwardings:    []*Awarding{},
+		},
+	}, posts)
+}
@@ -5,11 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/google/go-querystring/query"
 )
@@ -99,6 +99,13 @@ type LiveThreadCreateOrUpdateRequest struct {
 	NSFW        *bool  `url:"nsfw,omitempty"`
 }
 
+func (r *LiveThreadCreateOrUpdateRequest) validate() error {
+	if utf8.RuneCountInString(r.Title) > 120 {
+		return errors.New("(*LiveThreadCreateOrUpdateRequest).Title: cannot be longer than 120 characters")
+	}
+	return nil
+}
+
 // LiveThreadContributor is a user that can contribute to a live thread.
 type LiveThreadContributor struct {
 	ID          string   `json:"id,omitempty"`
@@ -223,7 +230,8 @@ func (s *LiveThreadService) Now(ctx context.Context) (*LiveThread, *Response, er
 	path := "api/live/happening_now"
 	t, resp, err := s.client.getThing(ctx, path, nil)
 	if err != nil {
-		if err == io.EOF && resp != nil && resp.StatusCode == http.StatusNoContent {
+		// the response is empty when there's no live thread happening now
+		if resp != nil && resp.StatusCode == http.StatusNoContent {
 			return nil, resp, nil
 		}
 		return nil, resp, err
@@ -259,6 +267,10 @@ func (s *LiveThreadService) GetMultiple(ctx context.Context, ids ...string) ([]*
 // Update the live thread by posting an update to it.
 // Requires the "update" permission.
 func (s *LiveThreadService) Update(ctx context.Context, id, text string) (*Response, error) {
+	if text == "" {
+		return nil, errors.New("text: cannot be empty")
+	}
+
 	form := url.Values{}
 	form.Set("api_type", "json")
 	form.Set("body", text)
@@ -349,6 +361,12 @@ func (s *LiveThreadService) Create(ctx context.Context, request *LiveThreadCreat
 	if request == nil {
 		return "", nil, errors.New("*LiveThreadCreateOrUpdateRequest: cannot be nil")
 	}
+	if request.Title == "" {
+		return "", nil, errors.New("(*LiveThreadCreateOrUpdateRequest).Title: cannot be empty")
+	}
+	if err := request.validate(); err != nil {
+		return "", nil, err
+	}
 
 	form, err := query.Values(request)
 	if err != nil {
@@ -397,6 +415,9 @@ func (s *LiveThreadService) Configure(ctx context.Context, id string, request *L
 	if request == nil {
 		return nil, errors.New("*LiveThreadCreateOrUpdateRequest: cannot be nil")
 	}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
 
 	form, err := query.Values(request)
 	if err != nil {
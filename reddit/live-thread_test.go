@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -126,6 +127,14 @@ var expectedLiveThreadDiscussions = []*Post{
 
 		Author:   "TestUser",
 		AuthorID: "t2_test1",
+
+		Thumbnail:          "default",
+		ThumbnailWidth:     140,
+		ThumbnailHeight:    140,
+		LinkFlairText:      "LIVE THREAD",
+		LinkFlairTextColor: "dark",
+		IsCrosspostable:    true,
+		AllAwardings:       []*Awarding{},
 	},
 	{
 		ID:      "test2",
@@ -149,6 +158,17 @@ var expectedLiveThreadDiscussions = []*Post{
 
 		Author:   "TestUser",
 		AuthorID: "t2_test1",
+
+		Thumbnail:                "https://b.thumbs.redditmedia.com/rZKNaYfha47BqSqVTn2S7WGm5-ydloMOqz3Oqli87aU.jpg",
+		ThumbnailWidth:           140,
+		ThumbnailHeight:          140,
+		LinkFlairID:              "9b12fc60-ff01-11e3-b179-12313b0a9e38",
+		LinkFlairText:            "LIVE THREAD CLOSED | No further updates.",
+		LinkFlairBackgroundColor: "#f5f5f5",
+		LinkFlairTextColor:       "dark",
+		SuggestedSort:            "new",
+		IsCrosspostable:          true,
+		AllAwardings:             []*Awarding{},
 	},
 }
 
@@ -249,7 +269,10 @@ func TestLiveThreadService_Update(t *testing.T) {
 		require.Equal(t, form, r.PostForm)
 	})
 
-	_, err := client.LiveThread.Update(ctx, "id123", "test")
+	_, err := client.LiveThread.Update(ctx, "id123", "")
+	require.EqualError(t, err, "text: cannot be empty")
+
+	_, err = client.LiveThread.Update(ctx, "id123", "test")
 	require.NoError(t, err)
 }
 
@@ -369,6 +392,12 @@ func TestLiveThreadService_Create(t *testing.T) {
 	_, _, err := client.LiveThread.Create(ctx, nil)
 	require.EqualError(t, err, "*LiveThreadCreateOrUpdateRequest: cannot be nil")
 
+	_, _, err = client.LiveThread.Create(ctx, &LiveThreadCreateOrUpdateRequest{Description: "testdescription"})
+	require.EqualError(t, err, "(*LiveThreadCreateOrUpdateRequest).Title: cannot be empty")
+
+	_, _, err = client.LiveThread.Create(ctx, &LiveThreadCreateOrUpdateRequest{Title: strings.Repeat("a", 121)})
+	require.EqualError(t, err, "(*LiveThreadCreateOrUpdateRequest).Title: cannot be longer than 120 characters")
+
 	id, _, err := client.LiveThread.Create(ctx, &LiveThreadCreateOrUpdateRequest{
 		Title:       "testtitle",
 		Description: "testdescription",
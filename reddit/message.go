@@ -4,13 +4,21 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/go-querystring/query"
 )
 
+// maxThreadLength is the maximum number of messages GetThread will collect
+// while following a message's parents, in case the chain loops back on itself.
+const maxThreadLength = 100
+
 // MessageService handles communication with the message
 // related methods of the Reddit API.
 //
@@ -27,12 +35,49 @@ type Message struct {
 
 	Subject  string `json:"subject"`
 	Text     string `json:"body"`
+	TextHTML string `json:"body_html"`
 	ParentID string `json:"parent_id"`
+	// The full ID of the first message in the conversation.
+	FirstMessageID string `json:"first_message_name"`
+
+	Author   string `json:"author"`
+	AuthorID string `json:"author_fullname"`
+	To       string `json:"dest"`
 
-	Author string `json:"author"`
-	To     string `json:"dest"`
+	// The kind of inbox item, e.g. "unknown" for private messages,
+	// or "comment_reply", "post_reply", or "username_mention".
+	Type string `json:"type"`
+	// The number of comments on the post, if the message is a comment.
+	NumberOfComments *int `json:"num_comments"`
+	// The ID of the award given, if the message is an award notification.
+	AssociatedAwardID string `json:"associated_awarding_id"`
 
 	IsComment bool `json:"was_comment"`
+	IsNew     bool `json:"new"`
+
+	Replies MessageReplies `json:"replies"`
+}
+
+// MessageReplies holds the replies to a message.
+type MessageReplies struct {
+	Messages []*Message `json:"messages,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *MessageReplies) UnmarshalJSON(data []byte) error {
+	// if a message has no replies, its "replies" field is set to ""
+	if string(data) == `""` {
+		return nil
+	}
+
+	root := new(inboxListing)
+	err := json.Unmarshal(data, root)
+	if err != nil {
+		return err
+	}
+
+	r.Messages = root.Messages
+	return nil
 }
 
 type inboxThing struct {
@@ -96,16 +141,78 @@ func (t *inboxThings) add(things ...inboxThing) {
 	}
 }
 
+// ModmailConversation is a conversation in the modmail of a subreddit.
+type ModmailConversation struct {
+	ID      string `json:"id"`
+	Subject string `json:"subject"`
+
+	Owner       ModmailOwner        `json:"owner"`
+	Participant *ModmailParticipant `json:"participant"`
+
+	LastUpdated    *Timestamp `json:"lastUpdated"`
+	LastUserUpdate *Timestamp `json:"lastUserUpdate"`
+	LastModUpdate  *Timestamp `json:"lastModUpdate"`
+	LastUnread     *Timestamp `json:"lastUnread"`
+
+	NumberOfMessages int `json:"numMessages"`
+	// 0 is new, 1 is in progress, 2 is archived.
+	State int `json:"state"`
+
+	IsAuto        bool `json:"isAuto"`
+	IsInternal    bool `json:"isInternal"`
+	IsHighlighted bool `json:"isHighlighted"`
+	IsRepliable   bool `json:"isRepliable"`
+}
+
+// lastUpdated returns the time the conversation was last updated, or the zero time if unknown.
+func (c *ModmailConversation) lastUpdated() time.Time {
+	if c.LastUpdated == nil {
+		return time.Time{}
+	}
+	return c.LastUpdated.Time
+}
+
+// ModmailOwner is the subreddit a modmail conversation belongs to.
+type ModmailOwner struct {
+	ID   string `json:"id"`
+	Name string `json:"displayName"`
+	Type string `json:"type"`
+}
+
+// ModmailParticipant is the user a modmail conversation is with.
+type ModmailParticipant struct {
+	Name  string `json:"name"`
+	IsMod bool   `json:"isMod"`
+	IsOP  bool   `json:"isOp"`
+}
+
 // SendMessageRequest represents a request to send a message.
 type SendMessageRequest struct {
 	// Username, or /r/name for that subreddit's moderators.
-	To      string `url:"to"`
+	To string `url:"to"`
+	// No longer than 100 characters.
 	Subject string `url:"subject"`
 	Text    string `url:"text"`
 	// Optional. If specified, the message will look like it came from the subreddit.
 	FromSubreddit string `url:"from_sr,omitempty"`
 }
 
+func (r *SendMessageRequest) validate() error {
+	if r.To == "" {
+		return errors.New("(*SendMessageRequest).To: cannot be empty")
+	}
+	if r.Subject == "" {
+		return errors.New("(*SendMessageRequest).Subject: cannot be empty")
+	}
+	if utf8.RuneCountInString(r.Subject) > 100 {
+		return errors.New("(*SendMessageRequest).Subject: cannot be longer than 100 characters")
+	}
+	if r.Text == "" {
+		return errors.New("(*SendMessageRequest).Text: cannot be empty")
+	}
+	return nil
+}
+
 // ReadAll marks all messages/comments as read. It queues up the task on Reddit's end.
 // A successful response returns 202 to acknowledge acceptance of the request.
 // This endpoint is heavily rate limited.
@@ -229,6 +336,9 @@ func (s *MessageService) Send(ctx context.Context, sendRequest *SendMessageReque
 	if sendRequest == nil {
 		return nil, errors.New("*SendMessageRequest: cannot be nil")
 	}
+	if err := sendRequest.validate(); err != nil {
+		return nil, err
+	}
 
 	path := "api/compose"
 
@@ -246,6 +356,80 @@ func (s *MessageService) Send(ctx context.Context, sendRequest *SendMessageReque
 	return s.client.Do(ctx, req, nil)
 }
 
+// GetThread returns the thread the message belongs to, in chronological order.
+// The thread is reconstructed by following the message's parents back to the first message.
+func (s *MessageService) GetThread(ctx context.Context, messageFullname string) ([]*Message, *Response, error) {
+	var thread []*Message
+	var resp *Response
+
+	// every message fetched so far, including the replies nested in the conversations
+	messages := make(map[string]*Message)
+	seen := make(map[string]bool)
+	id := messageFullname
+
+	for id != "" {
+		if seen[id] {
+			return nil, resp, fmt.Errorf("message thread loops back on %s", id)
+		}
+		if len(thread) == maxThreadLength {
+			return nil, resp, fmt.Errorf("message thread exceeds the maximum length of %d", maxThreadLength)
+		}
+		seen[id] = true
+
+		message, ok := messages[id]
+		if !ok {
+			var err error
+			resp, err = s.conversation(ctx, id, messages)
+			if err != nil {
+				return nil, resp, err
+			}
+
+			message, ok = messages[id]
+			if !ok {
+				return nil, resp, fmt.Errorf("message %s not found", id)
+			}
+		}
+
+		thread = append(thread, message)
+		id = message.ParentID
+	}
+
+	// the messages were collected newest to oldest
+	for i, j := 0, len(thread)-1; i < j; i, j = i+1, j-1 {
+		thread[i], thread[j] = thread[j], thread[i]
+	}
+
+	return thread, resp, nil
+}
+
+// conversation gets the conversation the message belongs to, and adds each of its messages
+// to the map, keyed by their full ID. Reddit returns the first message of the conversation,
+// with the others nested in its replies.
+func (s *MessageService) conversation(ctx context.Context, id string, messages map[string]*Message) (*Response, error) {
+	path := fmt.Sprintf("message/messages/%s", strings.TrimPrefix(id, kindMessage+"_"))
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(inboxListing)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return resp, err
+	}
+
+	addMessages(messages, root.Messages)
+	return resp, nil
+}
+
+func addMessages(messages map[string]*Message, list []*Message) {
+	for _, message := range list {
+		messages[message.FullID] = message
+		addMessages(messages, message.Replies.Messages)
+	}
+}
+
 // Inbox returns comments and messages that appear in your inbox, respectively.
 func (s *MessageService) Inbox(ctx context.Context, opts *ListOptions) ([]*Message, []*Message, *Response, error) {
 	root, resp, err := s.inbox(ctx, "message/inbox", opts)
@@ -292,3 +476,42 @@ func (s *MessageService) inbox(ctx context.Context, path string, opts *ListOptio
 
 	return root, resp, nil
 }
+
+// ModmailConversations returns the modmail conversations of the subreddits you moderate,
+// from most to least recently updated.
+func (s *MessageService) ModmailConversations(ctx context.Context, opts *ListModmailConversationsOptions) ([]*ModmailConversation, *Response, error) {
+	path := "api/mod/conversations"
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Conversations map[string]*ModmailConversation `json:"conversations"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	conversations := make([]*ModmailConversation, 0, len(root.Conversations))
+	for _, conversation := range root.Conversations {
+		conversations = append(conversations, conversation)
+	}
+
+	// the conversations are keyed by ID, so their order in the response is lost
+	sort.Slice(conversations, func(i, j int) bool {
+		a, b := conversations[i].lastUpdated(), conversations[j].lastUpdated()
+		if a.Equal(b) {
+			return conversations[i].ID < conversations[j].ID
+		}
+		return a.After(b)
+	})
+
+	return conversations, resp, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,11 +19,15 @@ var expectedCommentMessages = []*Message{
 
 		Subject:  "post reply",
 		Text:     "u/testuser2 hello",
+		TextHTML: "&lt;!-- SC_OFF --&gt;&lt;div class=\"md\"&gt;&lt;p&gt;&lt;a href=\"/u/testuser2\"&gt;u/testuser2&lt;/a&gt; hello&lt;/p&gt;\n&lt;/div&gt;&lt;!-- SC_ON --&gt;",
 		ParentID: "t3_hs03f3",
 
 		Author: "testuser1",
 		To:     "testuser2",
 
+		Type:             "post_reply",
+		NumberOfComments: Int(17),
+
 		IsComment: true,
 	},
 }
@@ -35,11 +40,16 @@ var expectedMessages = []*Message{
 
 		Subject:  "re: test",
 		Text:     "test",
+		TextHTML: "&lt;!-- SC_OFF --&gt;&lt;div class=\"md\"&gt;&lt;p&gt;test&lt;/p&gt;\n&lt;/div&gt;&lt;!-- SC_ON --&gt;",
 		ParentID: "t4_qwki4m",
 
+		FirstMessageID: "t4_qwkhao",
+
 		Author: "testuser1",
 		To:     "testuser2",
 
+		Type: "unknown",
+
 		IsComment: false,
 	},
 }
@@ -198,6 +208,18 @@ func TestMessageService_Send(t *testing.T) {
 	_, err := client.Message.Send(ctx, nil)
 	require.EqualError(t, err, "*SendMessageRequest: cannot be nil")
 
+	_, err = client.Message.Send(ctx, &SendMessageRequest{Subject: "test subject", Text: "test text"})
+	require.EqualError(t, err, "(*SendMessageRequest).To: cannot be empty")
+
+	_, err = client.Message.Send(ctx, &SendMessageRequest{To: "test", Text: "test text"})
+	require.EqualError(t, err, "(*SendMessageRequest).Subject: cannot be empty")
+
+	_, err = client.Message.Send(ctx, &SendMessageRequest{To: "test", Subject: strings.Repeat("a", 101), Text: "test text"})
+	require.EqualError(t, err, "(*SendMessageRequest).Subject: cannot be longer than 100 characters")
+
+	_, err = client.Message.Send(ctx, &SendMessageRequest{To: "test", Subject: "test subject"})
+	require.EqualError(t, err, "(*SendMessageRequest).Text: cannot be empty")
+
 	_, err = client.Message.Send(ctx, &SendMessageRequest{
 		To:            "test",
 		Subject:       "test subject",
@@ -207,6 +229,28 @@ func TestMessageService_Send(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMessageService_Send_Headers(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/compose", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		// requests are authenticated via OAuth2, so no modhash is needed
+		require.Equal(t, mediaTypeForm, r.Header.Get(headerContentType))
+		require.Equal(t, mediaTypeJSON, r.Header.Get(headerAccept))
+		require.Equal(t, client.UserAgent(), r.Header.Get(headerUserAgent))
+		require.Equal(t, "Bearer token1", r.Header.Get("Authorization"))
+		require.Empty(t, r.Header.Get("X-Modhash"))
+	})
+
+	_, err := client.Message.Send(ctx, &SendMessageRequest{
+		To:      "test",
+		Subject: "test subject",
+		Text:    "test text",
+	})
+	require.NoError(t, err)
+}
+
 func TestMessageService_Inbox(t *testing.T) {
 	client, mux := setup(t)
 
@@ -224,6 +268,64 @@ func TestMessageService_Inbox(t *testing.T) {
 	require.Equal(t, expectedMessages, messages)
 }
 
+func TestMessageService_Inbox_Replies(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/message/inbox-replies.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/message/inbox", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	comments, messages, _, err := client.Message.Inbox(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, comments, 0)
+	require.Equal(t, []*Message{
+		{
+			ID:      "qwkhao",
+			FullID:  "t4_qwkhao",
+			Created: &Timestamp{time.Date(2020, 8, 18, 0, 35, 0, 0, time.UTC)},
+
+			Subject:  "hello",
+			Text:     "hello",
+			TextHTML: "&lt;!-- SC_OFF --&gt;&lt;div class=\"md\"&gt;&lt;p&gt;hello&lt;/p&gt;\n&lt;/div&gt;&lt;!-- SC_ON --&gt;",
+
+			Author:   "testuser1",
+			AuthorID: "t2_testuser1",
+			To:       "testuser2",
+
+			Type: "unknown",
+
+			Replies: MessageReplies{
+				Messages: []*Message{
+					{
+						ID:      "qwkj2b",
+						FullID:  "t4_qwkj2b",
+						Created: &Timestamp{time.Date(2020, 8, 18, 0, 38, 20, 0, time.UTC)},
+
+						Subject:  "re: hello",
+						Text:     "hi back",
+						TextHTML: "&lt;!-- SC_OFF --&gt;&lt;div class=\"md\"&gt;&lt;p&gt;hi back&lt;/p&gt;\n&lt;/div&gt;&lt;!-- SC_ON --&gt;",
+						ParentID: "t4_qwkhao",
+
+						FirstMessageID: "t4_qwkhao",
+
+						Author:   "testuser2",
+						AuthorID: "t2_testuser2",
+						To:       "testuser1",
+
+						Type: "unknown",
+
+						IsNew: true,
+					},
+				},
+			},
+		},
+	}, messages)
+}
+
 func TestMessageService_InboxUnread(t *testing.T) {
 	client, mux := setup(t)
 
@@ -256,3 +358,117 @@ func TestMessageService_Sent(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedMessages, messages)
 }
+
+func TestMessageService_GetThread(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/message/thread.json")
+	require.NoError(t, err)
+
+	var count int
+	mux.HandleFunc("/message/messages/qwki97", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		count++
+		fmt.Fprint(w, blob)
+	})
+
+	thread, _, err := client.Message.GetThread(ctx, "t4_qwki97")
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Len(t, thread, 3)
+
+	require.Equal(t, "t4_qwkhao", thread[0].FullID)
+	require.Equal(t, "first", thread[0].Text)
+	require.Empty(t, thread[0].ParentID)
+	require.Equal(t, &Timestamp{time.Date(2020, 8, 18, 0, 16, 40, 0, time.UTC)}, thread[0].Created)
+
+	require.Equal(t, "t4_qwki4m", thread[1].FullID)
+	require.Equal(t, "second", thread[1].Text)
+	require.Equal(t, "t4_qwkhao", thread[1].ParentID)
+	require.Equal(t, "t4_qwkhao", thread[1].FirstMessageID)
+
+	require.Equal(t, "t4_qwki97", thread[2].FullID)
+	require.Equal(t, "third", thread[2].Text)
+	require.Equal(t, "t4_qwki4m", thread[2].ParentID)
+}
+
+func TestMessageService_GetThread_NotFound(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/message/thread.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/message/messages/abc", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Message.GetThread(ctx, "t4_abc")
+	require.EqualError(t, err, "message t4_abc not found")
+}
+
+func TestMessageService_GetThread_Loop(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/message/messages/aaa", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{"kind": "Listing", "data": {"children": [{"kind": "t4", "data": {"id": "aaa", "name": "t4_aaa", "parent_id": "t4_aaa"}}]}}`)
+	})
+
+	_, _, err := client.Message.GetThread(ctx, "t4_aaa")
+	require.EqualError(t, err, "message thread loops back on t4_aaa")
+}
+
+func TestMessageService_ModmailConversations(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/message/modmail-conversations.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/mod/conversations", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("entity", "testsubreddit")
+		form.Set("state", "all")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	conversations, _, err := client.Message.ModmailConversations(ctx, &ListModmailConversationsOptions{
+		Subreddits: "testsubreddit",
+		State:      "all",
+	})
+	require.NoError(t, err)
+
+	lastUpdated1 := time.Date(2020, 8, 7, 3, 13, 18, 491339000, time.UTC)
+	lastUserUpdate1 := time.Date(2020, 8, 6, 9, 15, 42, 931000, time.UTC)
+	lastUpdated2 := time.Date(2020, 8, 5, 14, 2, 11, 120471000, time.UTC)
+
+	require.Len(t, conversations, 2)
+
+	// most recently updated first
+	require.Equal(t, "fbd3k", conversations[0].ID)
+	require.Equal(t, "fbd0j", conversations[1].ID)
+
+	require.Equal(t, "why was my post removed?", conversations[0].Subject)
+	require.Equal(t, ModmailOwner{ID: "t5_2uquw1", Name: "testsubreddit", Type: "subreddit"}, conversations[0].Owner)
+	require.Equal(t, &ModmailParticipant{Name: "testuser2", IsOP: true}, conversations[0].Participant)
+	require.True(t, lastUpdated1.Equal(conversations[0].LastUpdated.Time))
+	require.True(t, lastUpdated1.Equal(conversations[0].LastModUpdate.Time))
+	require.True(t, lastUserUpdate1.Equal(conversations[0].LastUserUpdate.Time))
+	require.Nil(t, conversations[0].LastUnread)
+	require.Equal(t, 2, conversations[0].NumberOfMessages)
+	require.Equal(t, 1, conversations[0].State)
+	require.True(t, conversations[0].IsHighlighted)
+	require.True(t, conversations[0].IsRepliable)
+
+	require.True(t, lastUpdated2.Equal(conversations[1].LastUpdated.Time))
+	require.Nil(t, conversations[1].LastModUpdate)
+	require.Equal(t, 1, conversations[1].NumberOfMessages)
+	require.Equal(t, 0, conversations[1].State)
+}
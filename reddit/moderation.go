@@ -2,10 +2,15 @@ package reddit
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strings"
+	"sync"
+	"unicode/utf8"
 
 	"github.com/google/go-querystring/query"
 )
@@ -93,14 +98,336 @@ type BanConfig struct {
 	Reason string `url:"reason,omitempty"`
 	// Not visible to the user being banned.
 	ModNote string `url:"note,omitempty"`
-	// How long the ban will last. 0-999. Leave nil for permanent.
+	// How long the ban will last, in days. 1-999. Leave nil for permanent.
 	Days *int `url:"duration,omitempty"`
 	// Note to include in the ban message to the user.
 	Message string `url:"ban_message,omitempty"`
+	// The full ID of the post or comment that led to the ban, if any.
+	Context string `url:"ban_context,omitempty"`
+}
+
+func (c *BanConfig) validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.Days != nil && (*c.Days < 1 || *c.Days > 999) {
+		return errors.New("(*BanConfig).Days: must be between 1-999")
+	}
+	if utf8.RuneCountInString(c.ModNote) > 300 {
+		return errors.New("(*BanConfig).ModNote: cannot be longer than 300 characters")
+	}
+	return nil
+}
+
+// ModNote is a note left by a moderator about a user, or a record of
+// a moderator action taken against them, within a subreddit.
+type ModNote struct {
+	ID      string      `json:"id,omitempty"`
+	Created *Timestamp  `json:"created_at,omitempty"`
+	Type    ModNoteType `json:"type,omitempty"`
+
+	Subreddit   string `json:"subreddit,omitempty"`
+	SubredditID string `json:"subreddit_id,omitempty"`
+	User        string `json:"user,omitempty"`
+	UserID      string `json:"user_id,omitempty"`
+	// The moderator who created the note or took the action.
+	Moderator   string `json:"operator,omitempty"`
+	ModeratorID string `json:"operator_id,omitempty"`
+
+	// Set when the note was written by a moderator.
+	UserNote *ModNoteUserNote `json:"user_note_data,omitempty"`
+	// Set when the note records a moderator action.
+	ModAction *ModNoteModAction `json:"mod_action_data,omitempty"`
+
+	// Used to paginate through a user's notes.
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// ModNoteUserNote is the content of a note written by a moderator.
+type ModNoteUserNote struct {
+	Note string `json:"note,omitempty"`
+	// Empty if the note has no label.
+	Label ModNoteLabel `json:"label,omitempty"`
+	// The full ID of the post or comment the note is about, if any.
+	RedditID string `json:"reddit_id,omitempty"`
+}
+
+// ModNoteModAction describes the moderator action a note records.
+type ModNoteModAction struct {
+	Action      string `json:"action,omitempty"`
+	Details     string `json:"details,omitempty"`
+	Description string `json:"description,omitempty"`
+	// The full ID of the post or comment the action was taken on, if any.
+	RedditID string `json:"reddit_id,omitempty"`
+}
+
+// ModNoteType is the kind of a mod note, e.g. a note written by a moderator, or a ban.
+type ModNoteType string
+
+// The types of mod notes.
+const (
+	ModNoteTypeNote          ModNoteType = "NOTE"
+	ModNoteTypeApproval      ModNoteType = "APPROVAL"
+	ModNoteTypeRemoval       ModNoteType = "REMOVAL"
+	ModNoteTypeBan           ModNoteType = "BAN"
+	ModNoteTypeMute          ModNoteType = "MUTE"
+	ModNoteTypeInvite        ModNoteType = "INVITE"
+	ModNoteTypeSpam          ModNoteType = "SPAM"
+	ModNoteTypeContentChange ModNoteType = "CONTENT_CHANGE"
+	ModNoteTypeModAction     ModNoteType = "MOD_ACTION"
+)
+
+var modNoteTypes = []ModNoteType{
+	ModNoteTypeNote, ModNoteTypeApproval, ModNoteTypeRemoval, ModNoteTypeBan, ModNoteTypeMute,
+	ModNoteTypeInvite, ModNoteTypeSpam, ModNoteTypeContentChange, ModNoteTypeModAction,
+}
+
+// AllModNoteTypes returns all the types of mod notes.
+func AllModNoteTypes() []ModNoteType {
+	types := make([]ModNoteType, len(modNoteTypes))
+	copy(types, modNoteTypes)
+	return types
+}
+
+func (t ModNoteType) String() string { return string(t) }
+
+// Valid reports whether the type is one Reddit knows about.
+func (t ModNoteType) Valid() bool {
+	for _, typ := range modNoteTypes {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// ModNoteLabel is the label a moderator can attach to a note about a user.
+type ModNoteLabel string
+
+// The labels of mod notes.
+const (
+	ModNoteLabelBotBan           ModNoteLabel = "BOT_BAN"
+	ModNoteLabelPermaBan         ModNoteLabel = "PERMA_BAN"
+	ModNoteLabelBan              ModNoteLabel = "BAN"
+	ModNoteLabelAbuseWarning     ModNoteLabel = "ABUSE_WARNING"
+	ModNoteLabelSpamWarning      ModNoteLabel = "SPAM_WARNING"
+	ModNoteLabelSpamWatch        ModNoteLabel = "SPAM_WATCH"
+	ModNoteLabelSolidContributor ModNoteLabel = "SOLID_CONTRIBUTOR"
+	ModNoteLabelHelpfulUser      ModNoteLabel = "HELPFUL_USER"
+)
+
+var modNoteLabels = []ModNoteLabel{
+	ModNoteLabelBotBan, ModNoteLabelPermaBan, ModNoteLabelBan, ModNoteLabelAbuseWarning,
+	ModNoteLabelSpamWarning, ModNoteLabelSpamWatch, ModNoteLabelSolidContributor, ModNoteLabelHelpfulUser,
+}
+
+// AllModNoteLabels returns all the labels that can be attached to a mod note.
+func AllModNoteLabels() []ModNoteLabel {
+	labels := make([]ModNoteLabel, len(modNoteLabels))
+	copy(labels, modNoteLabels)
+	return labels
+}
+
+func (l ModNoteLabel) String() string { return string(l) }
+
+// Valid reports whether the label is one Reddit knows about.
+func (l ModNoteLabel) Valid() bool {
+	for _, label := range modNoteLabels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// ModNoteCreateRequest represents a request to write a note about a user in a subreddit.
+type ModNoteCreateRequest struct {
+	Subreddit string `url:"subreddit"`
+	User      string `url:"user"`
+	// No longer than 250 characters.
+	Note string `url:"note"`
+	// Optional.
+	Label ModNoteLabel `url:"label,omitempty"`
+	// Optional. The full ID of the post or comment the note is about.
+	RedditID string `url:"reddit_id,omitempty"`
+}
+
+func (r *ModNoteCreateRequest) validate() error {
+	if r.Subreddit == "" {
+		return errors.New("(*ModNoteCreateRequest).Subreddit: cannot be empty")
+	}
+	if r.User == "" {
+		return errors.New("(*ModNoteCreateRequest).User: cannot be empty")
+	}
+	if r.Note == "" {
+		return errors.New("(*ModNoteCreateRequest).Note: cannot be empty")
+	}
+	if utf8.RuneCountInString(r.Note) > 250 {
+		return errors.New("(*ModNoteCreateRequest).Note: cannot be longer than 250 characters")
+	}
+	if r.Label != "" && !r.Label.Valid() {
+		return fmt.Errorf("(*ModNoteCreateRequest).Label: %q is not a valid label", r.Label)
+	}
+	return nil
+}
+
+// maxRecentModNotePairs is the maximum number of subreddit/user pairs
+// Reddit accepts in a single request for recent mod notes.
+const maxRecentModNotePairs = 500
+
+// maxRecentModNoteBatches is the maximum number of batches AllRecentNotes requests at once.
+const maxRecentModNoteBatches = 4
+
+// RecentModNotesRequest represents a request to get the most recent mod note
+// of each user in the corresponding subreddit, i.e. Users[i] in Subreddits[i].
+type RecentModNotesRequest struct {
+	Subreddits []string
+	Users      []string
+}
+
+func (r *RecentModNotesRequest) validate() error {
+	if len(r.Subreddits) != len(r.Users) {
+		return errors.New("*RecentModNotesRequest: Subreddits and Users must be the same length")
+	}
+	if len(r.Subreddits) == 0 {
+		return errors.New("*RecentModNotesRequest: must provide at least 1 subreddit/user pair")
+	}
+	if len(r.Subreddits) > maxRecentModNotePairs {
+		return fmt.Errorf("*RecentModNotesRequest: cannot provide more than %d subreddit/user pairs", maxRecentModNotePairs)
+	}
+	return nil
+}
+
+// Report is a reason a post or comment was reported for.
+type Report struct {
+	Reason string `json:"reason"`
+	// The number of users who reported the item for this reason.
+	// Only set for user reports.
+	Count int `json:"count,omitempty"`
+	// The moderator who reported the item. Only set for moderator reports.
+	Moderator string `json:"moderator,omitempty"`
+}
+
+// ReportedThing is a post or comment that has been reported, along with its reports.
+// Exactly one of Post or Comment is set.
+type ReportedThing struct {
+	Post    *Post    `json:"post,omitempty"`
+	Comment *Comment `json:"comment,omitempty"`
+
+	NumReports  int       `json:"num_reports"`
+	UserReports []*Report `json:"user_reports,omitempty"`
+	ModReports  []*Report `json:"mod_reports,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *ReportedThing) UnmarshalJSON(b []byte) error {
+	root := new(struct {
+		Kind string          `json:"kind"`
+		Data json.RawMessage `json:"data"`
+	})
+
+	err := json.Unmarshal(b, root)
+	if err != nil {
+		return err
+	}
+
+	switch root.Kind {
+	case kindPost:
+		t.Post = new(Post)
+		err = json.Unmarshal(root.Data, t.Post)
+	case kindComment:
+		t.Comment = new(Comment)
+		err = json.Unmarshal(root.Data, t.Comment)
+	default:
+		return fmt.Errorf("unexpected kind %q, expected %q or %q", root.Kind, kindPost, kindComment)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Each user report is [reason, count, snoozed, can snooze],
+	// and each moderator report is [reason, moderator].
+	reports := new(struct {
+		NumReports  *int                `json:"num_reports"`
+		UserReports [][]json.RawMessage `json:"user_reports"`
+		ModReports  [][]json.RawMessage `json:"mod_reports"`
+	})
+
+	err = json.Unmarshal(root.Data, reports)
+	if err != nil {
+		return err
+	}
+
+	if reports.NumReports != nil {
+		t.NumReports = *reports.NumReports
+	}
+
+	for _, r := range reports.UserReports {
+		report := new(Report)
+		if err := unmarshalReport(r, &report.Reason, &report.Count); err != nil {
+			return err
+		}
+		t.UserReports = append(t.UserReports, report)
+	}
+
+	for _, r := range reports.ModReports {
+		report := new(Report)
+		if err := unmarshalReport(r, &report.Reason, &report.Moderator); err != nil {
+			return err
+		}
+		t.ModReports = append(t.ModReports, report)
+	}
+
+	return nil
+}
+
+func unmarshalReport(report []json.RawMessage, reason *string, v interface{}) error {
+	if len(report) < 2 {
+		return fmt.Errorf("unexpected report: %s", report)
+	}
+	if err := json.Unmarshal(report[0], reason); err != nil {
+		return err
+	}
+	return json.Unmarshal(report[1], v)
+}
+
+type reportedListing struct {
+	Things []*ReportedThing
+	after  string
+}
+
+func (l *reportedListing) After() string {
+	return l.after
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (l *reportedListing) UnmarshalJSON(b []byte) error {
+	root := new(struct {
+		Data struct {
+			Things []*ReportedThing `json:"children"`
+			After  string           `json:"after"`
+		} `json:"data"`
+	})
+
+	err := json.Unmarshal(b, root)
+	if err != nil {
+		return err
+	}
+
+	l.Things = root.Data.Things
+	l.after = root.Data.After
+
+	return nil
 }
 
 // Actions gets a list of moderator actions on a subreddit.
 func (s *ModerationService) Actions(ctx context.Context, subreddit string, opts *ListModActionOptions) ([]*ModAction, *Response, error) {
+	if opts != nil {
+		if err := opts.validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	path := fmt.Sprintf("r/%s/about/log", subreddit)
 	l, resp, err := s.client.getListing(ctx, path, opts)
 	if err != nil {
@@ -109,6 +436,248 @@ func (s *ModerationService) Actions(ctx context.Context, subreddit string, opts
 	return l.ModActions(), resp, nil
 }
 
+// RecentNotes returns the most recent mod note of each user in the corresponding subreddit.
+// The result is in the same order as the pairs in the request. If a user has no notes
+// in the subreddit, their entry is nil.
+func (s *ModerationService) RecentNotes(ctx context.Context, request *RecentModNotesRequest) ([]*ModNote, *Response, error) {
+	if request == nil {
+		return nil, nil, errors.New("*RecentModNotesRequest: cannot be nil")
+	}
+	if err := request.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	params := struct {
+		Subreddits string `url:"subreddits"`
+		Users      string `url:"users"`
+	}{strings.Join(request.Subreddits, ","), strings.Join(request.Users, ",")}
+
+	path, err := addOptions("api/mod/notes/recent", params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Notes []*ModNote `json:"mod_notes"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Notes, resp, nil
+}
+
+type modNoteListing struct {
+	Notes       []*ModNote `json:"mod_notes"`
+	EndCursor   string     `json:"end_cursor"`
+	HasNextPage bool       `json:"has_next_page"`
+}
+
+// After returns the cursor of the next (older) page of notes, if there is one.
+func (l *modNoteListing) After() string {
+	if !l.HasNextPage {
+		return ""
+	}
+	return l.EndCursor
+}
+
+// Notes returns a page of the notes about the user in the subreddit, newest first.
+// If there are older notes, the response's After field holds the cursor to pass as
+// the Before option to get them.
+func (s *ModerationService) Notes(ctx context.Context, subreddit, user string, opts *ListModNotesOptions) ([]*ModNote, *Response, error) {
+	if subreddit == "" {
+		return nil, nil, errors.New("subreddit: cannot be empty")
+	}
+	if user == "" {
+		return nil, nil, errors.New("user: cannot be empty")
+	}
+	if opts != nil {
+		if err := opts.validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	params := struct {
+		Subreddit string `url:"subreddit"`
+		User      string `url:"user"`
+	}{subreddit, user}
+
+	path, err := addOptions("api/mod/notes", params)
+	if err != nil {
+		return nil, nil, err
+	}
+	path, err = addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(modNoteListing)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Notes, resp, nil
+}
+
+// ModNoteIterator pages through the notes about a user in a subreddit, newest first.
+// Use ModerationService.NewModNoteIterator to create one.
+type ModNoteIterator struct {
+	service   *ModerationService
+	subreddit string
+	user      string
+	opts      ListModNotesOptions
+
+	// the cursors used so far, in case Reddit returns one of them again
+	cursors map[string]bool
+	done    bool
+}
+
+// NewModNoteIterator returns an iterator over the notes about the user in the subreddit.
+// The Before option is used as the starting point, if set. No request is made until Next is called.
+func (s *ModerationService) NewModNoteIterator(subreddit, user string, opts *ListModNotesOptions) *ModNoteIterator {
+	it := &ModNoteIterator{
+		service:   s,
+		subreddit: subreddit,
+		user:      user,
+		cursors:   make(map[string]bool),
+	}
+	if opts != nil {
+		it.opts = *opts
+	}
+	if it.opts.Before != "" {
+		it.cursors[it.opts.Before] = true
+	}
+	return it
+}
+
+// Next returns the next page of notes. Once Done returns true, it returns nil without
+// making a request. If it returns an error, the same page is requested on the next call.
+func (it *ModNoteIterator) Next(ctx context.Context) ([]*ModNote, *Response, error) {
+	if it.done {
+		return nil, nil, nil
+	}
+
+	notes, resp, err := it.service.Notes(ctx, it.subreddit, it.user, &it.opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	cursor := resp.After
+	if cursor == "" || len(notes) == 0 || it.cursors[cursor] {
+		it.done = true
+	} else {
+		it.cursors[cursor] = true
+		it.opts.Before = cursor
+	}
+
+	return notes, resp, nil
+}
+
+// Done reports whether there are no more pages of notes to get.
+func (it *ModNoteIterator) Done() bool {
+	return it.done
+}
+
+// AllRecentNotes is like RecentNotes, but accepts any number of subreddit/user pairs.
+// The pairs are split into batches of at most 500, up to 4 of which are requested concurrently.
+// The result is in the same order as the pairs in the request, and the returned
+// response is the one of the last batch.
+func (s *ModerationService) AllRecentNotes(ctx context.Context, request *RecentModNotesRequest) ([]*ModNote, *Response, error) {
+	if request == nil {
+		return nil, nil, errors.New("*RecentModNotesRequest: cannot be nil")
+	}
+	if len(request.Subreddits) != len(request.Users) {
+		return nil, nil, errors.New("*RecentModNotesRequest: Subreddits and Users must be the same length")
+	}
+
+	var batches []*RecentModNotesRequest
+	for start := 0; start < len(request.Subreddits); start += maxRecentModNotePairs {
+		end := start + maxRecentModNotePairs
+		if end > len(request.Subreddits) {
+			end = len(request.Subreddits)
+		}
+		batches = append(batches, &RecentModNotesRequest{
+			Subreddits: request.Subreddits[start:end],
+			Users:      request.Users[start:end],
+		})
+	}
+	if len(batches) == 0 {
+		return nil, nil, errors.New("*RecentModNotesRequest: must provide at least 1 subreddit/user pair")
+	}
+
+	notes := make([][]*ModNote, len(batches))
+	resps := make([]*Response, len(batches))
+	errs := make([]error, len(batches))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxRecentModNoteBatches)
+	for i, batch := range batches {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, batch *RecentModNotesRequest) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			notes[i], resps[i], errs[i] = s.RecentNotes(ctx, batch)
+		}(i, batch)
+	}
+	wg.Wait()
+
+	var results []*ModNote
+	for i := range batches {
+		if errs[i] != nil {
+			return nil, resps[i], errs[i]
+		}
+		results = append(results, notes[i]...)
+	}
+
+	return results, resps[len(resps)-1], nil
+}
+
+// CreateNote writes a note about a user in a subreddit you moderate.
+func (s *ModerationService) CreateNote(ctx context.Context, request *ModNoteCreateRequest) (*ModNote, *Response, error) {
+	if request == nil {
+		return nil, nil, errors.New("*ModNoteCreateRequest: cannot be nil")
+	}
+	if err := request.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	form, err := query.Values(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path := "api/mod/notes"
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Created *ModNote `json:"created"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Created, resp, nil
+}
+
 // AcceptInvite accepts a pending invite to moderate the specified subreddit.
 func (s *ModerationService) AcceptInvite(ctx context.Context, subreddit string) (*Response, error) {
 	path := fmt.Sprintf("r/%s/api/accept_moderator_invite", subreddit)
@@ -211,6 +780,29 @@ func (s *ModerationService) Reported(ctx context.Context, subreddit string, opts
 	return l.Posts(), l.Comments(), resp, nil
 }
 
+// Reports returns posts and comments that have been reported, along with
+// the reasons they were reported for by users and moderators.
+func (s *ModerationService) Reports(ctx context.Context, subreddit string, opts *ListOptions) ([]*ReportedThing, *Response, error) {
+	path := fmt.Sprintf("r/%s/about/reports", subreddit)
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(reportedListing)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Things, resp, nil
+}
+
 // Spam returns posts and comments marked as spam.
 func (s *ModerationService) Spam(ctx context.Context, subreddit string, opts *ListOptions) ([]*Post, []*Comment, *Response, error) {
 	path := fmt.Sprintf("r/%s/about/spam", subreddit)
@@ -306,6 +898,11 @@ func (s *ModerationService) Uninvite(ctx context.Context, subreddit string, user
 	return s.deleteRelationship(ctx, subreddit, username, "moderator_invite")
 }
 
+// RemoveModerator removes a user as a moderator of the subreddit.
+func (s *ModerationService) RemoveModerator(ctx context.Context, subreddit string, username string) (*Response, error) {
+	return s.deleteRelationship(ctx, subreddit, username, "moderator")
+}
+
 // SetPermissions sets the mod permissions for the user in the subreddit.
 // If permissions is nil, all permissions will be granted.
 func (s *ModerationService) SetPermissions(ctx context.Context, subreddit string, username string, permissions *ModPermissions) (*Response, error) {
@@ -327,6 +924,10 @@ func (s *ModerationService) SetPermissions(ctx context.Context, subreddit string
 
 // Ban a user from the subreddit.
 func (s *ModerationService) Ban(ctx context.Context, subreddit string, username string, config *BanConfig) (*Response, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("r/%s/api/friend", subreddit)
 
 	form, err := query.Values(config)
@@ -353,6 +954,10 @@ func (s *ModerationService) Unban(ctx context.Context, subreddit string, usernam
 
 // BanWiki bans a user from contributing to the subreddit wiki.
 func (s *ModerationService) BanWiki(ctx context.Context, subreddit string, username string, config *BanConfig) (*Response, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("r/%s/api/friend", subreddit)
 
 	form, err := query.Values(config)
@@ -379,7 +984,32 @@ func (s *ModerationService) UnbanWiki(ctx context.Context, subreddit string, use
 
 // Mute a user in the subreddit.
 func (s *ModerationService) Mute(ctx context.Context, subreddit string, username string) (*Response, error) {
-	return s.createRelationship(ctx, subreddit, username, "muted")
+	return s.MuteWithNote(ctx, subreddit, username, "")
+}
+
+// MuteWithNote mutes a user in the subreddit, leaving a note visible only to moderators.
+// The note cannot be longer than 300 characters.
+func (s *ModerationService) MuteWithNote(ctx context.Context, subreddit string, username string, note string) (*Response, error) {
+	if utf8.RuneCountInString(note) > 300 {
+		return nil, errors.New("note: cannot be longer than 300 characters")
+	}
+
+	path := fmt.Sprintf("r/%s/api/friend", subreddit)
+
+	form := url.Values{}
+	form.Set("api_type", "json")
+	form.Set("name", username)
+	form.Set("type", "muted")
+	if note != "" {
+		form.Set("note", note)
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
 }
 
 // Unmute a user in the subreddit.
@@ -442,6 +1072,10 @@ func (s *ModerationService) deleteRelationship(ctx context.Context, subreddit, u
 // Distinguish your post or comment via its full ID, adding a moderator tag to it.
 // todo: add how=admin and how=special? They require special privileges.
 func (s *ModerationService) Distinguish(ctx context.Context, id string) (*Response, error) {
+	if id == "" {
+		return nil, errors.New("id: cannot be empty")
+	}
+
 	path := "api/distinguish"
 
 	form := url.Values{}
@@ -459,7 +1093,12 @@ func (s *ModerationService) Distinguish(ctx context.Context, id string) (*Respon
 
 // DistinguishAndSticky your comment via its full ID, adding a moderator tag to it
 // and stickying the comment at the top of the thread.
+// Only top-level comments can be stickied.
 func (s *ModerationService) DistinguishAndSticky(ctx context.Context, id string) (*Response, error) {
+	if !strings.HasPrefix(id, kindComment+"_") {
+		return nil, errors.New("id: must be the full ID of a comment, since only comments can be stickied")
+	}
+
 	path := "api/distinguish"
 
 	form := url.Values{}
@@ -478,6 +1117,10 @@ func (s *ModerationService) DistinguishAndSticky(ctx context.Context, id string)
 
 // Undistinguish your post or comment via its full ID, removing the moderator tag from it.
 func (s *ModerationService) Undistinguish(ctx context.Context, id string) (*Response, error) {
+	if id == "" {
+		return nil, errors.New("id: cannot be empty")
+	}
+
 	path := "api/distinguish"
 
 	form := url.Values{}
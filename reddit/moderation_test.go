@@ -1,9 +1,13 @@
 package reddit
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -55,7 +59,7 @@ func TestModerationService_Actions(t *testing.T) {
 		require.Equal(t, http.MethodGet, r.Method)
 
 		form := url.Values{}
-		form.Set("type", "testtype")
+		form.Set("type", "banuser")
 		form.Set("mod", "testmod")
 
 		err := r.ParseForm()
@@ -65,12 +69,485 @@ func TestModerationService_Actions(t *testing.T) {
 		fmt.Fprint(w, blob)
 	})
 
-	modActions, resp, err := client.Moderation.Actions(ctx, "testsubreddit", &ListModActionOptions{Type: "testtype", Moderator: "testmod"})
+	_, _, err = client.Moderation.Actions(ctx, "testsubreddit", &ListModActionOptions{Type: "testtype"})
+	require.EqualError(t, err, `(*ListModActionOptions).Type: "testtype" is not a valid action type`)
+
+	_, _, err = client.Moderation.Actions(ctx, "testsubreddit", &ListModActionOptions{ListOptions: ListOptions{Limit: 501}})
+	require.EqualError(t, err, "(*ListModActionOptions).Limit: 501 is greater than the max of 500")
+
+	modActions, resp, err := client.Moderation.Actions(ctx, "testsubreddit", &ListModActionOptions{Type: "banuser", Moderator: "testmod"})
 	require.NoError(t, err)
 	require.Equal(t, expectedModActions, modActions)
 	require.Equal(t, "ModAction_a0408162-c4ad-11ea-8239-0e3b48262e8b", resp.After)
 }
 
+func TestModerationService_Actions_Forbidden(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/about/log", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message": "Forbidden", "error": 403}`)
+	})
+
+	modActions, resp, err := client.Moderation.Actions(ctx, "testsubreddit", nil)
+	require.IsType(t, &ErrorResponse{}, err)
+	require.EqualError(t, err, fmt.Sprintf("GET %s/r/testsubreddit/about/log: 403 Forbidden", client.BaseURL))
+	require.Nil(t, modActions)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestModNoteType(t *testing.T) {
+	require.Len(t, AllModNoteTypes(), 9)
+	for _, typ := range AllModNoteTypes() {
+		require.True(t, typ.Valid(), "type %s should be valid", typ)
+	}
+	require.False(t, ModNoteType("UNKNOWN").Valid())
+	require.False(t, ModNoteType("note").Valid())
+	require.Equal(t, "CONTENT_CHANGE", ModNoteTypeContentChange.String())
+}
+
+func TestModNoteLabel(t *testing.T) {
+	require.Len(t, AllModNoteLabels(), 8)
+	for _, label := range AllModNoteLabels() {
+		require.True(t, label.Valid(), "label %s should be valid", label)
+	}
+	require.False(t, ModNoteLabel("UNKNOWN").Valid())
+	require.False(t, ModNoteLabel("").Valid())
+	require.Equal(t, "HELPFUL_USER", ModNoteLabelHelpfulUser.String())
+}
+
+func TestModerationService_CreateNote(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/moderation/note-create.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/mod/notes", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("subreddit", "test")
+		form.Set("user", "testuser")
+		form.Set("note", "helps out in the weekly thread")
+		form.Set("label", "HELPFUL_USER")
+		form.Set("reddit_id", "t1_f0zsa37")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Moderation.CreateNote(ctx, nil)
+	require.EqualError(t, err, "*ModNoteCreateRequest: cannot be nil")
+
+	_, _, err = client.Moderation.CreateNote(ctx, &ModNoteCreateRequest{Subreddit: "test", User: "testuser"})
+	require.EqualError(t, err, "(*ModNoteCreateRequest).Note: cannot be empty")
+
+	_, _, err = client.Moderation.CreateNote(ctx, &ModNoteCreateRequest{Subreddit: "test", User: "testuser", Note: strings.Repeat("a", 251)})
+	require.EqualError(t, err, "(*ModNoteCreateRequest).Note: cannot be longer than 250 characters")
+
+	_, _, err = client.Moderation.CreateNote(ctx, &ModNoteCreateRequest{Subreddit: "test", User: "testuser", Note: "test", Label: "GREAT_USER"})
+	require.EqualError(t, err, `(*ModNoteCreateRequest).Label: "GREAT_USER" is not a valid label`)
+
+	note, _, err := client.Moderation.CreateNote(ctx, &ModNoteCreateRequest{
+		Subreddit: "test",
+		User:      "testuser",
+		Note:      "helps out in the weekly thread",
+		Label:     ModNoteLabelHelpfulUser,
+		RedditID:  "t1_f0zsa37",
+	})
+	require.NoError(t, err)
+	require.Equal(t, &ModNote{
+		ID:      "ModNote_e2f4a6c8-3c2a-11ed-b1d2-7a3c5e9f0d12",
+		Created: &Timestamp{time.Date(2022, 9, 22, 18, 21, 5, 0, time.UTC)},
+		Type:    ModNoteTypeNote,
+
+		Subreddit:   "test",
+		SubredditID: "t5_2qh23",
+		User:        "testuser",
+		UserID:      "t2_3p7zdf",
+		Moderator:   "v_95",
+		ModeratorID: "t2_164ab8",
+
+		UserNote: &ModNoteUserNote{
+			Note:     "helps out in the weekly thread",
+			Label:    ModNoteLabelHelpfulUser,
+			RedditID: "t1_f0zsa37",
+		},
+		ModAction: &ModNoteModAction{},
+
+		Cursor: "MTY2Mzg3MDg2NQ==",
+	}, note)
+}
+
+func TestModerationService_Notes(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/moderation/notes-page-1.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/mod/notes", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("subreddit", "test")
+		form.Set("user", "testuser")
+		form.Set("limit", "2")
+		form.Set("filter", "NOTE")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Moderation.Notes(ctx, "", "testuser", nil)
+	require.EqualError(t, err, "subreddit: cannot be empty")
+
+	_, _, err = client.Moderation.Notes(ctx, "test", "", nil)
+	require.EqualError(t, err, "user: cannot be empty")
+
+	_, _, err = client.Moderation.Notes(ctx, "test", "testuser", &ListModNotesOptions{Limit: 101})
+	require.EqualError(t, err, "(*ListModNotesOptions).Limit: 101 is greater than the max of 100")
+
+	_, _, err = client.Moderation.Notes(ctx, "test", "testuser", &ListModNotesOptions{Filter: "WARNING"})
+	require.EqualError(t, err, `(*ListModNotesOptions).Filter: "WARNING" is not a valid mod note type`)
+
+	notes, resp, err := client.Moderation.Notes(ctx, "test", "testuser", &ListModNotesOptions{Limit: 2, Filter: ModNoteTypeNote})
+	require.NoError(t, err)
+	require.Len(t, notes, 2)
+	require.Equal(t, &ModNote{
+		ID:      "ModNote_f3a1c5e7-3c2b-11ed-8a4d-5e6f7a8b9c0d",
+		Created: &Timestamp{time.Date(2022, 9, 22, 18, 21, 5, 0, time.UTC)},
+		Type:    ModNoteTypeNote,
+
+		Subreddit:   "test",
+		SubredditID: "t5_2qh23",
+		User:        "testuser",
+		UserID:      "t2_3p7zdf",
+		Moderator:   "v_95",
+		ModeratorID: "t2_164ab8",
+
+		UserNote: &ModNoteUserNote{
+			Note:  "second warning",
+			Label: ModNoteLabelSpamWarning,
+		},
+		ModAction: &ModNoteModAction{},
+
+		Cursor: "MTY2Mzg3MDg2NQ==",
+	}, notes[0])
+	require.Equal(t, "MTY2Mzc4MzQ2NQ==", resp.After)
+}
+
+func TestModNoteIterator(t *testing.T) {
+	client, mux := setup(t)
+
+	page1, err := readFileContents("../testdata/moderation/notes-page-1.json")
+	require.NoError(t, err)
+
+	page2, err := readFileContents("../testdata/moderation/notes-page-2.json")
+	require.NoError(t, err)
+
+	var befores []string
+	mux.HandleFunc("/api/mod/notes", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "test", r.Form.Get("subreddit"))
+		require.Equal(t, "testuser", r.Form.Get("user"))
+
+		before := r.Form.Get("before")
+		befores = append(befores, before)
+
+		switch before {
+		case "":
+			fmt.Fprint(w, page1)
+		case "MTY2Mzc4MzQ2NQ==":
+			fmt.Fprint(w, page2)
+		default:
+			t.Errorf("unexpected before: %q", before)
+		}
+	})
+
+	it := client.Moderation.NewModNoteIterator("test", "testuser", nil)
+	require.False(t, it.Done())
+
+	var ids []string
+	for !it.Done() {
+		notes, _, err := it.Next(ctx)
+		require.NoError(t, err)
+		for _, note := range notes {
+			ids = append(ids, note.ID)
+		}
+	}
+
+	require.Equal(t, []string{"", "MTY2Mzc4MzQ2NQ=="}, befores)
+	require.Equal(t, []string{
+		"ModNote_f3a1c5e7-3c2b-11ed-8a4d-5e6f7a8b9c0d",
+		"ModNote_b5c8d2a0-3b1e-11ed-9d0e-a6f1a0cb3fbc",
+		"ModNote_7d2e9b14-3a55-11ed-93c7-2b4f6d8e0a1c",
+	}, ids)
+
+	// no more requests are made once the iterator is done
+	notes, resp, err := it.Next(ctx)
+	require.NoError(t, err)
+	require.Nil(t, notes)
+	require.Nil(t, resp)
+	require.Len(t, befores, 2)
+}
+
+func TestModNoteIterator_CancelledMidway(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/moderation/notes-page-1.json")
+	require.NoError(t, err)
+
+	var count int
+	mux.HandleFunc("/api/mod/notes", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		count++
+		fmt.Fprint(w, blob)
+	})
+
+	cancellableCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	it := client.Moderation.NewModNoteIterator("test", "testuser", nil)
+
+	var pages int
+	for !it.Done() {
+		_, _, err = it.Next(cancellableCtx)
+		if err != nil {
+			break
+		}
+		pages++
+
+		// the caller gives up after the first page
+		cancel()
+	}
+
+	require.True(t, errors.Is(err, context.Canceled), "expected context.Canceled, got: %v", err)
+	require.Equal(t, 1, pages)
+	require.Equal(t, 1, count)
+}
+
+func TestModNoteIterator_RepeatedCursor(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/moderation/notes-page-1.json")
+	require.NoError(t, err)
+
+	var count int
+	mux.HandleFunc("/api/mod/notes", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		count++
+		// always returns the same page, with the same cursor
+		fmt.Fprint(w, blob)
+	})
+
+	it := client.Moderation.NewModNoteIterator("test", "testuser", nil)
+	for !it.Done() {
+		_, _, err := it.Next(ctx)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, count)
+}
+
+func TestModerationService_RecentNotes(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/moderation/notes-recent.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/mod/notes/recent", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("subreddits", "test,test,golang")
+		form.Set("users", "testuser,testuser3,testuser2")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	notes, _, err := client.Moderation.RecentNotes(ctx, &RecentModNotesRequest{
+		Subreddits: []string{"test", "test", "golang"},
+		Users:      []string{"testuser", "testuser3", "testuser2"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*ModNote{
+		{
+			ID:      "ModNote_b5c8d2a0-3b1e-11ed-9d0e-a6f1a0cb3fbc",
+			Created: &Timestamp{time.Date(2022, 9, 21, 18, 4, 25, 0, time.UTC)},
+			Type:    "NOTE",
+
+			Subreddit:   "test",
+			SubredditID: "t5_2qh23",
+			User:        "testuser",
+			UserID:      "t2_3p7zdf",
+			Moderator:   "v_95",
+			ModeratorID: "t2_164ab8",
+
+			UserNote: &ModNoteUserNote{
+				Note:     "repeatedly posting off-topic links",
+				Label:    "SPAM_WARNING",
+				RedditID: "t3_hs0cyh",
+			},
+			ModAction: &ModNoteModAction{},
+
+			Cursor: "MTY2Mzc4MzQ2NQ==",
+		},
+		nil,
+		{
+			ID:      "ModNote_c1a9f7e4-3b1f-11ed-a4c1-8e2d3a3b8f11",
+			Created: &Timestamp{time.Date(2022, 9, 21, 18, 11, 42, 0, time.UTC)},
+			Type:    "BAN",
+
+			Subreddit:   "golang",
+			SubredditID: "t5_2rc7j",
+			User:        "testuser2",
+			UserID:      "t2_5dn1kc",
+			Moderator:   "v_95",
+			ModeratorID: "t2_164ab8",
+
+			UserNote: &ModNoteUserNote{},
+			ModAction: &ModNoteModAction{
+				Action:      "banuser",
+				Details:     "permanent",
+				Description: "harassment",
+			},
+
+			Cursor: "MTY2Mzc4MzkwMg==",
+		},
+	}, notes)
+}
+
+func TestModerationService_RecentNotes_Invalid(t *testing.T) {
+	client, _ := setup(t)
+
+	_, _, err := client.Moderation.RecentNotes(ctx, nil)
+	require.EqualError(t, err, "*RecentModNotesRequest: cannot be nil")
+
+	_, _, err = client.Moderation.RecentNotes(ctx, &RecentModNotesRequest{})
+	require.EqualError(t, err, "*RecentModNotesRequest: must provide at least 1 subreddit/user pair")
+
+	_, _, err = client.Moderation.RecentNotes(ctx, &RecentModNotesRequest{
+		Subreddits: []string{"test", "golang"},
+		Users:      []string{"testuser"},
+	})
+	require.EqualError(t, err, "*RecentModNotesRequest: Subreddits and Users must be the same length")
+
+	subreddits := make([]string, 501)
+	users := make([]string, 501)
+	for i := range subreddits {
+		subreddits[i] = "test"
+		users[i] = fmt.Sprintf("testuser%d", i)
+	}
+
+	_, _, err = client.Moderation.RecentNotes(ctx, &RecentModNotesRequest{
+		Subreddits: subreddits,
+		Users:      users,
+	})
+	require.EqualError(t, err, "*RecentModNotesRequest: cannot provide more than 500 subreddit/user pairs")
+}
+
+func TestModerationService_AllRecentNotes(t *testing.T) {
+	client, mux := setup(t)
+
+	subreddits := make([]string, 600)
+	users := make([]string, 600)
+	for i := range subreddits {
+		subreddits[i] = "test"
+		users[i] = fmt.Sprintf("testuser%d", i)
+	}
+
+	var mu sync.Mutex
+	var batchSizes []int
+
+	mux.HandleFunc("/api/mod/notes/recent", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		batch := strings.Split(r.Form.Get("users"), ",")
+		require.Len(t, strings.Split(r.Form.Get("subreddits"), ","), len(batch))
+
+		mu.Lock()
+		batchSizes = append(batchSizes, len(batch))
+		mu.Unlock()
+
+		notes := make([]string, len(batch))
+		for i, user := range batch {
+			notes[i] = fmt.Sprintf(`{"user": %q}`, user)
+		}
+		fmt.Fprintf(w, `{"mod_notes": [%s]}`, strings.Join(notes, ","))
+	})
+
+	notes, _, err := client.Moderation.AllRecentNotes(ctx, &RecentModNotesRequest{
+		Subreddits: subreddits,
+		Users:      users,
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int{500, 100}, batchSizes)
+	require.Len(t, notes, 600)
+	for i, note := range notes {
+		require.Equal(t, users[i], note.User)
+	}
+
+	_, _, err = client.Moderation.AllRecentNotes(ctx, &RecentModNotesRequest{})
+	require.EqualError(t, err, "*RecentModNotesRequest: must provide at least 1 subreddit/user pair")
+}
+
+func TestModerationService_AllRecentNotes_Concurrency(t *testing.T) {
+	client, mux := setup(t)
+
+	pairs := maxRecentModNotePairs * (maxRecentModNoteBatches + 2)
+	subreddits := make([]string, pairs)
+	users := make([]string, pairs)
+	for i := range subreddits {
+		subreddits[i] = "test"
+		users[i] = fmt.Sprintf("testuser%d", i)
+	}
+
+	var mu sync.Mutex
+	var inFlight, maxInFlight, count int
+
+	mux.HandleFunc("/api/mod/notes/recent", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		count++
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		fmt.Fprint(w, `{"mod_notes": []}`)
+	})
+
+	_, _, err := client.Moderation.AllRecentNotes(ctx, &RecentModNotesRequest{
+		Subreddits: subreddits,
+		Users:      users,
+	})
+	require.NoError(t, err)
+	require.Equal(t, maxRecentModNoteBatches+2, count)
+	require.LessOrEqual(t, maxInFlight, maxRecentModNoteBatches)
+}
+
 func TestModerationService_AcceptInvite(t *testing.T) {
 	client, mux := setup(t)
 
@@ -210,6 +687,44 @@ func TestModerationService_Reported(t *testing.T) {
 	require.Equal(t, "t1_f0zsa37", resp.After)
 }
 
+func TestModerationService_Reports(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/moderation/reports.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/about/reports", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	things, resp, err := client.Moderation.Reports(ctx, "testsubreddit", nil)
+	require.NoError(t, err)
+	require.Equal(t, "t1_gbkp2t7", resp.After)
+	require.Len(t, things, 2)
+
+	require.NotNil(t, things[0].Post)
+	require.Nil(t, things[0].Comment)
+	require.Equal(t, "t3_jq4hzm", things[0].Post.FullID)
+	require.Equal(t, 3, things[0].NumReports)
+	require.Equal(t, []*Report{
+		{Reason: "Spam", Count: 2},
+		{Reason: "Threatening, harassing, or inciting violence", Count: 1},
+	}, things[0].UserReports)
+	require.Equal(t, []*Report{
+		{Reason: "Off topic", Moderator: "test_mod"},
+	}, things[0].ModReports)
+
+	require.Nil(t, things[1].Post)
+	require.NotNil(t, things[1].Comment)
+	require.Equal(t, "t1_gbkp2t7", things[1].Comment.FullID)
+	require.Equal(t, 1, things[1].NumReports)
+	require.Equal(t, []*Report{
+		{Reason: "This is abusive or harassing", Count: 1},
+	}, things[1].UserReports)
+	require.Nil(t, things[1].ModReports)
+}
+
 func TestModerationService_Spam(t *testing.T) {
 	client, mux := setup(t)
 
@@ -302,6 +817,36 @@ func TestModerationService_Edited(t *testing.T) {
 	require.Equal(t, "t1_f0zsa37", resp.After)
 }
 
+func TestModerationService_Edited_Options(t *testing.T) {
+	client, mux := setup(t)
+
+	// contains posts and comments
+	blob, err := readFileContents("../testdata/user/overview.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/about/edited", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "10")
+		form.Set("after", "t3_test")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, comments, _, err := client.Moderation.Edited(ctx, "testsubreddit", &ListOptions{
+		Limit: 10,
+		After: "t3_test",
+	})
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	require.Len(t, comments, 1)
+}
+
 func TestModerationService_IgnoreReports(t *testing.T) {
 	client, mux := setup(t)
 
@@ -400,6 +945,26 @@ func TestModerationService_Uninvite(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestModerationService_RemoveModerator(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/unfriend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("type", "moderator")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.RemoveModerator(ctx, "testsubreddit", "testuser")
+	require.NoError(t, err)
+}
+
 func TestModerationService_SetPermissions(t *testing.T) {
 	client, mux := setup(t)
 
@@ -450,6 +1015,55 @@ func TestModerationService_Ban(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestModerationService_Ban_Permanent(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/friend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("type", "banned")
+		form.Set("reason", "test reason")
+		form.Set("ban_context", "t1_test")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.Ban(ctx, "testsubreddit", "testuser", &BanConfig{
+		Reason:  "test reason",
+		Context: "t1_test",
+	})
+	require.NoError(t, err)
+}
+
+func TestModerationService_Ban_Invalid(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/friend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.Moderation.Ban(ctx, "testsubreddit", "testuser", &BanConfig{Days: Int(1000)})
+	require.EqualError(t, err, "(*BanConfig).Days: must be between 1-999")
+
+	_, err = client.Moderation.Ban(ctx, "testsubreddit", "testuser", &BanConfig{Days: Int(0)})
+	require.EqualError(t, err, "(*BanConfig).Days: must be between 1-999")
+
+	_, err = client.Moderation.BanWiki(ctx, "testsubreddit", "testuser", &BanConfig{Days: Int(-1)})
+	require.EqualError(t, err, "(*BanConfig).Days: must be between 1-999")
+
+	_, err = client.Moderation.Ban(ctx, "testsubreddit", "testuser", &BanConfig{ModNote: strings.Repeat("x", 301)})
+	require.EqualError(t, err, "(*BanConfig).ModNote: cannot be longer than 300 characters")
+
+	// the length is counted in characters, not bytes
+	_, err = client.Moderation.Ban(ctx, "testsubreddit", "testuser", &BanConfig{ModNote: strings.Repeat("é", 300)})
+	require.NoError(t, err)
+}
+
 func TestModerationService_Unban(t *testing.T) {
 	client, mux := setup(t)
 
@@ -539,7 +1153,49 @@ func TestModerationService_Mute(t *testing.T) {
 	require.NoError(t, err)
 }
 
-func TestModerationService_Unmuted(t *testing.T) {
+func TestModerationService_MuteWithNote(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/friend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("type", "muted")
+		form.Set("note", "spamming modmail")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.MuteWithNote(ctx, "testsubreddit", "testuser", strings.Repeat("x", 301))
+	require.EqualError(t, err, "note: cannot be longer than 300 characters")
+
+	_, err = client.Moderation.MuteWithNote(ctx, "testsubreddit", "testuser", "spamming modmail")
+	require.NoError(t, err)
+}
+
+func TestModerationService_MuteWithNote_NonASCII(t *testing.T) {
+	client, mux := setup(t)
+
+	note := strings.Repeat("é", 300)
+
+	mux.HandleFunc("/r/testsubreddit/api/friend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, note, r.PostForm.Get("note"))
+	})
+
+	// the length is counted in characters, not bytes
+	_, err := client.Moderation.MuteWithNote(ctx, "testsubreddit", "testuser", note)
+	require.NoError(t, err)
+}
+
+func TestModerationService_Unmute(t *testing.T) {
 	client, mux := setup(t)
 
 	mux.HandleFunc("/r/testsubreddit/api/unfriend", func(w http.ResponseWriter, r *http.Request) {
@@ -680,6 +1336,22 @@ func TestModerationService_DistinguishAndSticky(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestModerationService_Distinguish_Invalid(t *testing.T) {
+	client, _ := setup(t)
+
+	_, err := client.Moderation.Distinguish(ctx, "")
+	require.EqualError(t, err, "id: cannot be empty")
+
+	_, err = client.Moderation.Undistinguish(ctx, "")
+	require.EqualError(t, err, "id: cannot be empty")
+
+	_, err = client.Moderation.DistinguishAndSticky(ctx, "")
+	require.EqualError(t, err, "id: must be the full ID of a comment, since only comments can be stickied")
+
+	_, err = client.Moderation.DistinguishAndSticky(ctx, "t3_123")
+	require.EqualError(t, err, "id: must be the full ID of a comment, since only comments can be stickied")
+}
+
 func TestModerationService_Undistinguish(t *testing.T) {
 	client, mux := setup(t)
 
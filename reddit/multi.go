@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"regexp"
 
 	"github.com/google/go-querystring/query"
 )
@@ -43,6 +44,40 @@ type Multi struct {
 	NSFW                bool   `json:"over_18"`
 }
 
+// MultiExpanded is a multireddit along with the full details
+// of each of its subreddits.
+type MultiExpanded struct {
+	Multi
+	SubredditDetails []*Subreddit `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (m *MultiExpanded) UnmarshalJSON(data []byte) error {
+	err := json.Unmarshal(data, &m.Multi)
+	if err != nil {
+		return err
+	}
+
+	root := new(struct {
+		Subreddits []struct {
+			Data *Subreddit `json:"data"`
+		} `json:"subreddits"`
+	})
+	err = json.Unmarshal(data, root)
+	if err != nil {
+		return err
+	}
+
+	m.SubredditDetails = make([]*Subreddit, 0, len(root.Subreddits))
+	for _, sr := range root.Subreddits {
+		if sr.Data != nil {
+			m.SubredditDetails = append(m.SubredditDetails, sr.Data)
+		}
+	}
+
+	return nil
+}
+
 // SubredditNames is a list of subreddit names.
 type SubredditNames []string
 
@@ -90,6 +125,28 @@ type MultiCopyRequest struct {
 	DisplayName string `url:"display_name,omitempty"`
 }
 
+func (r *MultiCopyRequest) validate() error {
+	if err := ValidateMultiPath(r.FromPath); err != nil {
+		return fmt.Errorf("(*MultiCopyRequest).FromPath: %w", err)
+	}
+	if err := ValidateMultiPath(r.ToPath); err != nil {
+		return fmt.Errorf("(*MultiCopyRequest).ToPath: %w", err)
+	}
+	return nil
+}
+
+var multiPathPattern = regexp.MustCompile(`^/?user/[^/]+/m/[^/]+/?$`)
+
+// ValidateMultiPath checks that p is the url path of a multireddit,
+// i.e. of the form user/{username}/m/{multiname}.
+// Leading and trailing slashes are allowed.
+func ValidateMultiPath(p string) error {
+	if !multiPathPattern.MatchString(p) {
+		return fmt.Errorf("%q: must be of the form user/{username}/m/{multiname}", p)
+	}
+	return nil
+}
+
 // MultiCreateOrUpdateRequest represents a request to create/update a multireddit.
 type MultiCreateOrUpdateRequest struct {
 	// For updates, this is the display name, i.e. the header of the multi.
@@ -116,6 +173,26 @@ func (s *MultiService) Get(ctx context.Context, multiPath string) (*Multi, *Resp
 	return multi, resp, nil
 }
 
+// GetExpanded gets the multireddit from its url path, along with
+// the full details of each of its subreddits.
+func (s *MultiService) GetExpanded(ctx context.Context, multiPath string) (*MultiExpanded, *Response, error) {
+	path := fmt.Sprintf("api/multi/%s?expand_srs=true", multiPath)
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Data *MultiExpanded `json:"data"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Data, resp, nil
+}
+
 // Mine returns your multireddits.
 func (s *MultiService) Mine(ctx context.Context) ([]*Multi, *Response, error) {
 	path := "api/multi/mine"
@@ -156,6 +233,9 @@ func (s *MultiService) Copy(ctx context.Context, copyRequest *MultiCopyRequest)
 	if copyRequest == nil {
 		return nil, nil, errors.New("*MultiCopyRequest: cannot be nil")
 	}
+	if err := copyRequest.validate(); err != nil {
+		return nil, nil, err
+	}
 
 	path := "api/multi/copy"
 	form, err := query.Values(copyRequest)
@@ -262,8 +342,13 @@ func (s *MultiService) Description(ctx context.Context, multiPath string) (strin
 
 // UpdateDescription updates a multireddit's description.
 func (s *MultiService) UpdateDescription(ctx context.Context, multiPath string, description string) (string, *Response, error) {
+	byteValue, err := json.Marshal(&rootMultiDescription{Body: description})
+	if err != nil {
+		return "", nil, err
+	}
+
 	form := url.Values{}
-	form.Set("model", fmt.Sprintf(`{"body_md":"%s"}`, description))
+	form.Set("model", string(byteValue))
 
 	path := fmt.Sprintf("api/multi/%s/description", multiPath)
 	req, err := s.client.NewRequest(http.MethodPut, path, form)
@@ -285,8 +370,15 @@ func (s *MultiService) UpdateDescription(ctx context.Context, multiPath string,
 func (s *MultiService) AddSubreddit(ctx context.Context, multiPath string, subreddit string) (*Response, error) {
 	path := fmt.Sprintf("api/multi/%s/r/%s", multiPath, subreddit)
 
+	byteValue, err := json.Marshal(&struct {
+		Name string `json:"name"`
+	}{subreddit})
+	if err != nil {
+		return nil, err
+	}
+
 	form := url.Values{}
-	form.Set("model", fmt.Sprintf(`{"name":"%s"}`, subreddit))
+	form.Set("model", string(byteValue))
 
 	req, err := s.client.NewRequest(http.MethodPut, path, form)
 	if err != nil {
@@ -59,6 +59,60 @@ func TestMultiService_Get(t *testing.T) {
 	require.Equal(t, expectedMulti, multi)
 }
 
+func TestMultiService_GetExpanded(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/multi/multi-expanded.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/multi/user/testuser/m/testmulti", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("expand_srs", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	multi, _, err := client.Multi.GetExpanded(ctx, "user/testuser/m/testmulti")
+	require.NoError(t, err)
+	require.Equal(t, *expectedMulti, multi.Multi)
+	require.Equal(t, []*Subreddit{
+		{
+			ID:      "2qo4s",
+			FullID:  "t5_2qo4s",
+			Created: &Timestamp{time.Date(2008, 2, 10, 7, 13, 17, 0, time.UTC)},
+
+			URL:          "/r/nba/",
+			Name:         "nba",
+			NamePrefixed: "r/nba",
+			Title:        "NBA",
+			Description:  "A community for NBA discussion.",
+			Type:         "public",
+
+			Subscribers: 3829375,
+		},
+		{
+			ID:      "2rc7j",
+			FullID:  "t5_2rc7j",
+			Created: &Timestamp{time.Date(2009, 11, 4, 5, 16, 51, 0, time.UTC)},
+
+			URL:          "/r/golang/",
+			Name:         "golang",
+			NamePrefixed: "r/golang",
+			Title:        "The Go Programming Language",
+			Description:  "Ask questions and post articles about the Go programming language and related tools, events etc.",
+			Type:         "public",
+
+			Subscribers: 128914,
+		},
+	}, multi.SubredditDetails)
+}
+
 func TestMultiService_Mine(t *testing.T) {
 	client, mux := setup(t)
 
@@ -126,6 +180,43 @@ func TestMultiService_Copy(t *testing.T) {
 	require.Equal(t, expectedMulti, multi)
 }
 
+func TestMultiService_Copy_InvalidPath(t *testing.T) {
+	client, _ := setup(t)
+
+	_, _, err := client.Multi.Copy(ctx, &MultiCopyRequest{
+		FromPath: "testuser/m/testmulti",
+		ToPath:   "user/testuser2/m/testmulti2",
+	})
+	require.EqualError(t, err, `(*MultiCopyRequest).FromPath: "testuser/m/testmulti": must be of the form user/{username}/m/{multiname}`)
+
+	_, _, err = client.Multi.Copy(ctx, &MultiCopyRequest{
+		FromPath: "user/testuser/m/testmulti",
+		ToPath:   "r/golang",
+	})
+	require.EqualError(t, err, `(*MultiCopyRequest).ToPath: "r/golang": must be of the form user/{username}/m/{multiname}`)
+}
+
+func TestValidateMultiPath(t *testing.T) {
+	for _, p := range []string{
+		"user/testuser/m/testmulti",
+		"/user/testuser/m/testmulti",
+		"/user/testuser/m/testmulti/",
+	} {
+		require.NoError(t, ValidateMultiPath(p), p)
+	}
+
+	for _, p := range []string{
+		"",
+		"testuser/m/testmulti",
+		"/u/testuser/m/testmulti",
+		"user/testuser/m/",
+		"user/testuser/m/testmulti/extra",
+		"r/golang",
+	} {
+		require.Error(t, ValidateMultiPath(p), p)
+	}
+}
+
 func TestMultiService_Create(t *testing.T) {
 	client, mux := setup(t)
 
@@ -250,6 +341,28 @@ func TestMultiService_UpdateDescription(t *testing.T) {
 	require.Equal(t, "hello world", description)
 }
 
+func TestMultiService_UpdateDescription_Escaped(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/multi/user/testuser/f/testfilter/description", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		var model map[string]string
+		err = json.Unmarshal([]byte(r.PostForm.Get("model")), &model)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"body_md": "a \"quoted\"\ndescription"}, model)
+
+		fmt.Fprint(w, `{"kind": "LabeledMultiDescription", "data": {"body_md": "a \"quoted\"\ndescription"}}`)
+	})
+
+	description, _, err := client.Multi.UpdateDescription(ctx, "user/testuser/f/testfilter", "a \"quoted\"\ndescription")
+	require.NoError(t, err)
+	require.Equal(t, "a \"quoted\"\ndescription", description)
+}
+
 func TestMultiService_AddSubreddit(t *testing.T) {
 	client, mux := setup(t)
 
@@ -278,3 +391,29 @@ func TestMultiService_DeleteSubreddit(t *testing.T) {
 	_, err := client.Multi.DeleteSubreddit(ctx, "user/testuser/m/testmulti", "golang")
 	require.NoError(t, err)
 }
+
+func TestMultiService_Filter(t *testing.T) {
+	client, mux := setup(t)
+
+	// filters, like user/testuser/f/all, are managed the same way as multireddits
+	mux.HandleFunc("/api/multi/user/testuser/f/all/r/golang", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			form := url.Values{}
+			form.Set("model", `{"name":"golang"}`)
+
+			err := r.ParseForm()
+			require.NoError(t, err)
+			require.Equal(t, form, r.PostForm)
+		case http.MethodDelete:
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	_, err := client.Multi.AddSubreddit(ctx, "user/testuser/f/all", "golang")
+	require.NoError(t, err)
+
+	_, err = client.Multi.DeleteSubreddit(ctx, "user/testuser/f/all", "golang")
+	require.NoError(t, err)
+}
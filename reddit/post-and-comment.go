@@ -2,9 +2,11 @@ package reddit
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 )
 
 // postAndCommentService handles communication with the post and comment
@@ -25,6 +27,31 @@ const (
 	upvote
 )
 
+// Reddit's API rules ask that votes be cast one at a time, with a delay between them.
+const batchVoteDelay = 2 * time.Second
+
+// sleep pauses for the duration, or until the context is done.
+// It is a variable so that tests don't have to wait.
+var sleep = func(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// VoteRequest is a vote to cast on a post or comment.
+type VoteRequest struct {
+	// The full ID of the post or comment.
+	ID string
+	// 1 to upvote, -1 to downvote, 0 to remove your vote.
+	Direction int
+}
+
 // Delete a post or comment via its full ID.
 func (s *postAndCommentService) Delete(ctx context.Context, id string) (*Response, error) {
 	path := "api/del"
@@ -180,3 +207,28 @@ func (s *postAndCommentService) Report(ctx context.Context, id string, reason st
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// BatchVote casts the votes one after the other, waiting 2 seconds between each one,
+// as required by Reddit's API rules.
+// The returned slice holds the error of each vote, at the same index as the vote; it is nil if the vote succeeded.
+// If the context is done before all votes are cast, the remaining votes are skipped and the context's error is returned.
+func (s *postAndCommentService) BatchVote(ctx context.Context, votes []VoteRequest) ([]error, error) {
+	errs := make([]error, len(votes))
+
+	for i, v := range votes {
+		if i > 0 {
+			if err := sleep(ctx, batchVoteDelay); err != nil {
+				return errs, err
+			}
+		}
+
+		if v.Direction < int(downvote) || v.Direction > int(upvote) {
+			errs[i] = fmt.Errorf("vote direction %d: must be one of -1, 0, 1", v.Direction)
+			continue
+		}
+
+		_, errs[i] = s.vote(ctx, v.ID, vote(v.Direction))
+	}
+
+	return errs, nil
+}
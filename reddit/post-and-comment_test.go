@@ -0,0 +1,170 @@
+package reddit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAndCommentService(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		// the form field holding the ID
+		idKey string
+		form  url.Values
+		do    func(s *postAndCommentService, id string) (*Response, error)
+	}{
+		{
+			name:  "Delete",
+			path:  "/api/del",
+			idKey: "id",
+			do: func(s *postAndCommentService, id string) (*Response, error) {
+				return s.Delete(ctx, id)
+			},
+		},
+		{
+			name:  "Save",
+			path:  "/api/save",
+			idKey: "id",
+			do: func(s *postAndCommentService, id string) (*Response, error) {
+				return s.Save(ctx, id)
+			},
+		},
+		{
+			name:  "Unsave",
+			path:  "/api/unsave",
+			idKey: "id",
+			do: func(s *postAndCommentService, id string) (*Response, error) {
+				return s.Unsave(ctx, id)
+			},
+		},
+		{
+			name:  "EnableReplies",
+			path:  "/api/sendreplies",
+			idKey: "id",
+			form:  url.Values{"state": {"true"}},
+			do: func(s *postAndCommentService, id string) (*Response, error) {
+				return s.EnableReplies(ctx, id)
+			},
+		},
+		{
+			name:  "DisableReplies",
+			path:  "/api/sendreplies",
+			idKey: "id",
+			form:  url.Values{"state": {"false"}},
+			do: func(s *postAndCommentService, id string) (*Response, error) {
+				return s.DisableReplies(ctx, id)
+			},
+		},
+		{
+			name:  "Lock",
+			path:  "/api/lock",
+			idKey: "id",
+			do: func(s *postAndCommentService, id string) (*Response, error) {
+				return s.Lock(ctx, id)
+			},
+		},
+		{
+			name:  "Unlock",
+			path:  "/api/unlock",
+			idKey: "id",
+			do: func(s *postAndCommentService, id string) (*Response, error) {
+				return s.Unlock(ctx, id)
+			},
+		},
+		{
+			name:  "Upvote",
+			path:  "/api/vote",
+			idKey: "id",
+			form:  url.Values{"dir": {"1"}, "rank": {"10"}},
+			do: func(s *postAndCommentService, id string) (*Response, error) {
+				return s.Upvote(ctx, id)
+			},
+		},
+		{
+			name:  "Downvote",
+			path:  "/api/vote",
+			idKey: "id",
+			form:  url.Values{"dir": {"-1"}, "rank": {"10"}},
+			do: func(s *postAndCommentService, id string) (*Response, error) {
+				return s.Downvote(ctx, id)
+			},
+		},
+		{
+			name:  "RemoveVote",
+			path:  "/api/vote",
+			idKey: "id",
+			form:  url.Values{"dir": {"0"}, "rank": {"10"}},
+			do: func(s *postAndCommentService, id string) (*Response, error) {
+				return s.RemoveVote(ctx, id)
+			},
+		},
+		{
+			name:  "Report",
+			path:  "/api/report",
+			idKey: "thing_id",
+			form:  url.Values{"api_type": {"json"}, "reason": {"test reason"}},
+			do: func(s *postAndCommentService, id string) (*Response, error) {
+				return s.Report(ctx, id, "test reason")
+			},
+		},
+	}
+
+	// The same methods are available on both the post and comment services.
+	for _, id := range []string{"t3_test", "t1_test"} {
+		for _, tt := range tests {
+			tt, id := tt, id
+			t.Run(fmt.Sprintf("%s/%s", tt.name, id), func(t *testing.T) {
+				client, mux := setup(t)
+
+				form := url.Values{}
+				for k, v := range tt.form {
+					form[k] = v
+				}
+				form.Set(tt.idKey, id)
+
+				mux.HandleFunc(tt.path, func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, http.MethodPost, r.Method)
+
+					err := r.ParseForm()
+					require.NoError(t, err)
+					require.Equal(t, form, r.PostForm)
+				})
+
+				s := client.Post.postAndCommentService
+				if id == "t1_test" {
+					s = client.Comment.postAndCommentService
+				}
+
+				resp, err := tt.do(s, id)
+				require.NoError(t, err)
+				require.Equal(t, http.StatusOK, resp.StatusCode)
+			})
+		}
+	}
+}
+
+func TestPostAndCommentService_Error(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/lock", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message": "Forbidden", "error": 403}`)
+	})
+
+	_, err := client.Post.Lock(ctx, "t3_test")
+	require.IsType(t, &ErrorResponse{}, err)
+	require.Equal(t, "Forbidden", err.(*ErrorResponse).Message)
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, err = client.Comment.Lock(cancelledCtx, "t1_test")
+	require.Error(t, err)
+}
@@ -1,14 +1,22 @@
 package reddit
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/google/go-querystring/query"
+	"golang.org/x/net/context/ctxhttp"
 )
 
 // PostService handles communication with the post
@@ -38,6 +46,9 @@ type SubmitTextRequest struct {
 	Subreddit string `url:"sr,omitempty"`
 	Title     string `url:"title,omitempty"`
 	Text      string `url:"text,omitempty"`
+	// The body of the post in Reddit's richtext JSON format.
+	// It can be used instead of Text, but not alongside it.
+	RichtextJSON string `url:"richtext_json,omitempty"`
 
 	FlairID   string `url:"flair_id,omitempty"`
 	FlairText string `url:"flair_text,omitempty"`
@@ -47,6 +58,19 @@ type SubmitTextRequest struct {
 	Spoiler     bool  `url:"spoiler,omitempty"`
 }
 
+func (r *SubmitTextRequest) validate() error {
+	if r.RichtextJSON == "" {
+		return nil
+	}
+	if r.Text != "" {
+		return errors.New("only one of Text or RichtextJSON may be set")
+	}
+	if !json.Valid([]byte(r.RichtextJSON)) {
+		return errors.New("RichtextJSON: must be valid JSON")
+	}
+	return nil
+}
+
 // SubmitLinkRequest are options used for link posts.
 type SubmitLinkRequest struct {
 	Subreddit string `url:"sr,omitempty"`
@@ -62,11 +86,136 @@ type SubmitLinkRequest struct {
 	Spoiler     bool  `url:"spoiler,omitempty"`
 }
 
+// SubmitGalleryRequest are options used for gallery posts.
+// A gallery must contain between 2 and 20 items.
+type SubmitGalleryRequest struct {
+	Subreddit string        `json:"sr,omitempty"`
+	Title     string        `json:"title,omitempty"`
+	Items     []GalleryItem `json:"items"`
+
+	FlairID   string `json:"flair_id,omitempty"`
+	FlairText string `json:"flair_text,omitempty"`
+
+	SendReplies *bool `json:"sendreplies,omitempty"`
+	NSFW        bool  `json:"nsfw"`
+	Spoiler     bool  `json:"spoiler"`
+}
+
+func (r *SubmitGalleryRequest) validate() error {
+	if len(r.Items) < 2 || len(r.Items) > 20 {
+		return errors.New("gallery must contain between 2 and 20 items")
+	}
+	for _, item := range r.Items {
+		if item.MediaID == "" {
+			return errors.New("gallery item media id: cannot be empty")
+		}
+	}
+	return nil
+}
+
+// SubmitVideoRequest are options used for video posts.
+type SubmitVideoRequest struct {
+	Subreddit string `url:"sr,omitempty"`
+	Title     string `url:"title,omitempty"`
+	// The URL of the image shown before the video is played.
+	VideoPosterURL string `url:"video_poster_url,omitempty"`
+	// If true, the video is submitted as a silent, looping gif.
+	GIF bool `url:"-"`
+
+	FlairID   string `url:"flair_id,omitempty"`
+	FlairText string `url:"flair_text,omitempty"`
+
+	SendReplies *bool `url:"sendreplies,omitempty"`
+	NSFW        bool  `url:"nsfw,omitempty"`
+	Spoiler     bool  `url:"spoiler,omitempty"`
+}
+
+// PollOption is an option users can vote for in a poll post.
+// Its text must not be longer than 25 characters.
+type PollOption struct {
+	Text string
+}
+
+// SubmitPollRequest are options used for poll posts.
+type SubmitPollRequest struct {
+	Subreddit string
+	Title     string
+	// The body of the post, usually the question being asked.
+	Question string
+	// Between 2 and 6 options.
+	Options []PollOption
+	// The number of days the poll stays open for, between 1 and 7.
+	DurationDays int
+
+	FlairID   string
+	FlairText string
+
+	SendReplies *bool
+	NSFW        bool
+	Spoiler     bool
+}
+
+func (r *SubmitPollRequest) validate() error {
+	if len(r.Options) < 2 || len(r.Options) > 6 {
+		return errors.New("poll must have between 2 and 6 options")
+	}
+	for _, option := range r.Options {
+		if option.Text == "" {
+			return errors.New("poll option: cannot be empty")
+		}
+		if utf8.RuneCountInString(option.Text) > 25 {
+			return fmt.Errorf("poll option %q: cannot be longer than 25 characters", option.Text)
+		}
+	}
+	if r.DurationDays < 1 || r.DurationDays > 7 {
+		return errors.New("poll duration must be between 1 and 7 days")
+	}
+	return nil
+}
+
+// richtext is a document in Reddit's rich text format.
+type richtext struct {
+	Document []richtextElement `json:"document"`
+}
+
+type richtextElement struct {
+	Element  string            `json:"e"`
+	Text     string            `json:"t,omitempty"`
+	Children []richtextElement `json:"c,omitempty"`
+}
+
+// newRichtextParagraph returns a rich text document containing a single paragraph of plain text.
+func newRichtextParagraph(text string) richtext {
+	return richtext{
+		Document: []richtextElement{
+			{Element: "par", Children: []richtextElement{{Element: "text", Text: text}}},
+		},
+	}
+}
+
 // Get a post with its comments.
 // id is the ID36 of the post, not its full id.
 // Example: instead of t3_abc123, use abc123.
 func (s *PostService) Get(ctx context.Context, id string) (*PostAndComments, *Response, error) {
+	return s.GetWithOptions(ctx, id, nil)
+}
+
+// GetWithOptions gets a post with its comments, like Get, but lets you choose
+// how the comments are sorted, how many are returned, and how deep the comment tree goes.
+// id is the ID36 of the post, not its full id.
+func (s *PostService) GetWithOptions(ctx context.Context, id string, opts *ListPostCommentsOptions) (*PostAndComments, *Response, error) {
+	if opts != nil {
+		if err := opts.validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	path := fmt.Sprintf("comments/%s", id)
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
 	if err != nil {
 		return nil, nil, err
@@ -137,6 +286,10 @@ func (s *PostService) submit(ctx context.Context, v interface{}) (*Submitted, *R
 
 // SubmitText submits a text post.
 func (s *PostService) SubmitText(ctx context.Context, opts SubmitTextRequest) (*Submitted, *Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	form := struct {
 		SubmitTextRequest
 		Kind string `url:"kind,omitempty"`
@@ -153,6 +306,215 @@ func (s *PostService) SubmitLink(ctx context.Context, opts SubmitLinkRequest) (*
 	return s.submit(ctx, form)
 }
 
+// SubmitGallery submits a gallery post.
+// The images must first be uploaded to Reddit; their media IDs are then used as the gallery's items.
+func (s *PostService) SubmitGallery(ctx context.Context, opts SubmitGalleryRequest) (*Submitted, *Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	path := "api/submit_gallery_post.json"
+
+	body := struct {
+		SubmitGalleryRequest
+		APIType string `json:"api_type"`
+	}{opts, "json"}
+
+	req, err := s.client.NewJSONRequest(http.MethodPost, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(rootSubmittedPost)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	submitted := root.JSON.Data
+	// The gallery endpoint returns the post's full ID in the id field.
+	if submitted != nil && submitted.FullID == "" && strings.HasPrefix(submitted.ID, kindPost+"_") {
+		submitted.FullID = submitted.ID
+		submitted.ID = strings.TrimPrefix(submitted.ID, kindPost+"_")
+	}
+
+	return submitted, resp, nil
+}
+
+// lease gets a lease from Reddit to upload a media file to their S3 bucket.
+// It returns the URL to upload the file to, and the form fields to send along with it.
+func (s *PostService) lease(ctx context.Context, mediaPath string) (string, map[string]string, *Response, error) {
+	path := "api/media/asset.json"
+
+	form := url.Values{}
+	form.Set("filepath", filepath.Base(mediaPath))
+	form.Set("mimetype", "video/mp4")
+	if strings.HasSuffix(strings.ToLower(mediaPath), ".mov") {
+		form.Set("mimetype", "video/quicktime")
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	var response struct {
+		Args struct {
+			Action string `json:"action"`
+			Fields []struct {
+				Name  string `json:"name"`
+				Value string `json:"value"`
+			} `json:"fields"`
+		} `json:"args"`
+	}
+
+	resp, err := s.client.Do(ctx, req, &response)
+	if err != nil {
+		return "", nil, resp, err
+	}
+
+	// The action is a protocol-relative URL, e.g. //reddit-uploaded-video.s3-accelerate.amazonaws.com
+	uploadURL := response.Args.Action
+	if strings.HasPrefix(uploadURL, "//") {
+		uploadURL = fmt.Sprintf("%s:%s", s.client.BaseURL.Scheme, uploadURL)
+	}
+
+	fields := make(map[string]string)
+	for _, field := range response.Args.Fields {
+		fields[field.Name] = field.Value
+	}
+
+	return uploadURL, fields, resp, nil
+}
+
+func (s *PostService) uploadMedia(ctx context.Context, uploadURL string, fields map[string]string, mediaPath string) (*Response, error) {
+	file, err := os.Open(mediaPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	// AWS ignores all fields in the request that come after the file field, so we need to set these before
+	for k, v := range fields {
+		writer.WriteField(k, v)
+	}
+
+	part, err := writer.CreateFormFile("file", filepath.Base(file.Name()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = io.Copy(part, file)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	httpResponse, err := ctxhttp.Post(ctx, nil, uploadURL, writer.FormDataContentType(), body)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	err = CheckResponse(httpResponse)
+	if err != nil {
+		return newResponse(httpResponse), err
+	}
+
+	return newResponse(httpResponse), nil
+}
+
+// SubmitVideo uploads the video file at videoPath to Reddit and submits it as a video post.
+// If opts.GIF is true, the video is submitted as a gif (silent and looping).
+func (s *PostService) SubmitVideo(ctx context.Context, opts SubmitVideoRequest, videoPath string) (*Submitted, *Response, error) {
+	uploadURL, fields, resp, err := s.lease(ctx, videoPath)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp, err = s.uploadMedia(ctx, uploadURL, fields, videoPath)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	kind := "video"
+	if opts.GIF {
+		kind = "videogif"
+	}
+
+	form := struct {
+		SubmitVideoRequest
+		Kind string `url:"kind,omitempty"`
+		URL  string `url:"url,omitempty"`
+	}{opts, kind, fmt.Sprintf("%s/%s", uploadURL, fields["key"])}
+	return s.submit(ctx, form)
+}
+
+// SubmitPoll submits a poll post.
+func (s *PostService) SubmitPoll(ctx context.Context, opts SubmitPollRequest) (*Submitted, *Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	richtextJSON, err := json.Marshal(newRichtextParagraph(opts.Question))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	options := make([]string, len(opts.Options))
+	for i, option := range opts.Options {
+		options[i] = option.Text
+	}
+
+	path := "api/submit_poll_post.json"
+
+	body := struct {
+		APIType      string   `json:"api_type"`
+		Subreddit    string   `json:"sr,omitempty"`
+		Title        string   `json:"title,omitempty"`
+		RichtextJSON string   `json:"richtext_json"`
+		Options      []string `json:"options"`
+		Duration     int      `json:"duration"`
+		FlairID      string   `json:"flair_id,omitempty"`
+		FlairText    string   `json:"flair_text,omitempty"`
+		SendReplies  *bool    `json:"sendreplies,omitempty"`
+		NSFW         bool     `json:"nsfw"`
+		Spoiler      bool     `json:"spoiler"`
+	}{
+		APIType:      "json",
+		Subreddit:    opts.Subreddit,
+		Title:        opts.Title,
+		RichtextJSON: string(richtextJSON),
+		Options:      options,
+		Duration:     opts.DurationDays,
+		FlairID:      opts.FlairID,
+		FlairText:    opts.FlairText,
+		SendReplies:  opts.SendReplies,
+		NSFW:         opts.NSFW,
+		Spoiler:      opts.Spoiler,
+	}
+
+	req, err := s.client.NewJSONRequest(http.MethodPost, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(rootSubmittedPost)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.JSON.Data, resp, nil
+}
+
 // Edit a post.
 func (s *PostService) Edit(ctx context.Context, id string, text string) (*Post, *Response, error) {
 	path := "api/editusertext"
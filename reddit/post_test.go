@@ -1,9 +1,16 @@
 package reddit
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -36,6 +43,11 @@ var expectedPostAndComments = &PostAndComments{
 		AuthorID: "t2_testuser",
 
 		IsSelfPost: true,
+
+		Thumbnail:          "self",
+		LinkFlairTextColor: "dark",
+		IsCrosspostable:    true,
+		AllAwardings:       []*Awarding{},
 	},
 	Comments: []*Comment{
 		{
@@ -58,11 +70,15 @@ var expectedPostAndComments = &PostAndComments{
 			Score:            1,
 			Controversiality: 0,
 
+			AllAwardings: []*Awarding{},
+
 			PostID: "t3_testpost",
 
 			IsSubmitter: true,
 			CanGild:     true,
 
+			AuthorFlairRichtext: []map[string]string{},
+
 			Replies: Replies{
 				Comments: []*Comment{
 					{
@@ -85,10 +101,15 @@ var expectedPostAndComments = &PostAndComments{
 						Score:            1,
 						Controversiality: 0,
 
+						AllAwardings: []*Awarding{},
+
 						PostID: "t3_testpost",
 
 						IsSubmitter: true,
 						CanGild:     true,
+
+						AuthorFlairRichtext: []map[string]string{},
+						Depth:               1,
 					},
 				},
 			},
@@ -130,6 +151,11 @@ var expectedEditedPost = &Post{
 
 	Spoiler:    true,
 	IsSelfPost: true,
+
+	Thumbnail:          "spoiler",
+	LinkFlairTextColor: "dark",
+	IsCrosspostable:    true,
+	AllAwardings:       []*Awarding{},
 }
 
 var expectedPost2 = &Post{
@@ -156,6 +182,11 @@ var expectedPost2 = &Post{
 
 	Author:   "v_95",
 	AuthorID: "t2_164ab8",
+
+	Thumbnail:          "default",
+	LinkFlairTextColor: "dark",
+	IsCrosspostable:    true,
+	AllAwardings:       []*Awarding{},
 }
 
 var expectedPostDuplicates = []*Post{
@@ -183,6 +214,11 @@ var expectedPostDuplicates = []*Post{
 
 		Author:   "GarlicoinAccount",
 		AuthorID: "t2_d2v1r90",
+
+		Thumbnail:          "default",
+		LinkFlairTextColor: "dark",
+		IsCrosspostable:    true,
+		AllAwardings:       []*Awarding{},
 	},
 	{
 		ID:      "le1tc",
@@ -208,6 +244,11 @@ var expectedPostDuplicates = []*Post{
 
 		Author:   "prog101",
 		AuthorID: "t2_8dyo",
+
+		Thumbnail:          "default",
+		LinkFlairTextColor: "dark",
+		IsCrosspostable:    true,
+		AllAwardings:       []*Awarding{},
 	},
 }
 
@@ -227,6 +268,190 @@ func TestPostService_Get(t *testing.T) {
 	require.Equal(t, expectedPostAndComments, postAndComments)
 }
 
+func TestPostService_Get_Crosspost(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/post/crosspost.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/comments/j1cd3e", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	preview := &PostPreview{
+		Images: []*PostPreviewImage{
+			{
+				ID:     "Lk3vB7yQnT0pR2sW8xZ4cD6fG9hJ1mN5qU7iO3eA0bE",
+				Source: &PostPreviewImageSource{URL: "https://preview.redd.it/9r8f2mxk3qp51.jpg?auto=webp&amp;s=5a2b8f1c0e7d6b4a3c2e1f0d9c8b7a6e5d4c3b2a", Width: 1080, Height: 720},
+				Resolutions: []*PostPreviewImageSource{
+					{URL: "https://preview.redd.it/9r8f2mxk3qp51.jpg?width=108&amp;crop=smart&amp;auto=webp&amp;s=1f2e3d4c5b6a79880716253443526170f1e2d3c4", Width: 108, Height: 72},
+					{URL: "https://preview.redd.it/9r8f2mxk3qp51.jpg?width=216&amp;crop=smart&amp;auto=webp&amp;s=a1b2c3d4e5f60718293a4b5c6d7e8f9012345678", Width: 216, Height: 144},
+				},
+			},
+		},
+		Enabled: true,
+	}
+
+	helpful := Award{
+		ID:          "award_f44611f1-b89e-46dc-97fe-892280b13b82",
+		Name:        "Helpful",
+		Description: "Thank you stranger. Shows the award.",
+		Type:        "global",
+		CoinPrice:   150,
+		IconURL:     "https://i.redd.it/award_images/t5_22cerq/klvxk1wggfd41_Helpful.png",
+	}
+	wholesome := Award{
+		ID:          "award_5f123e3d-4f48-42f4-9c11-e98b566d5897",
+		Name:        "Wholesome",
+		Description: "When you come across a feel-good thing.",
+		Type:        "global",
+		CoinPrice:   125,
+		IconURL:     "https://i.redd.it/award_images/t5_22cerq/5izbv4fn0md41_Wholesome.png",
+	}
+
+	postAndComments, _, err := client.Post.Get(ctx, "j1cd3e")
+	require.NoError(t, err)
+	require.Empty(t, postAndComments.Comments)
+	require.Equal(t, &Post{
+		ID:      "j1cd3e",
+		FullID:  "t3_j1cd3e",
+		Created: &Timestamp{time.Date(2020, 9, 28, 7, 24, 5, 0, time.UTC)},
+		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+
+		Permalink:       "/r/test/comments/j1cd3e/sunset_over_the_lake/",
+		URL:             "https://i.redd.it/9r8f2mxk3qp51.jpg",
+		Thumbnail:       "https://b.thumbs.redditmedia.com/q7W3pZk9xL2vR5tY8uB1nM4cF6hJ0sD3gK7aE2iO9lQ.jpg",
+		ThumbnailWidth:  140,
+		ThumbnailHeight: 93,
+		PostHint:        "image",
+
+		Title: "Sunset over the lake",
+
+		LinkFlairID:              "b8a1c0f2-01c9-11eb-8f4e-0e5d3c3f1a6d",
+		LinkFlairText:            "Photography",
+		LinkFlairBackgroundColor: "#46d160",
+		LinkFlairTextColor:       "light",
+
+		SuggestedSort: "new",
+
+		Score:               12,
+		UpvoteRatio:         0.93,
+		TotalAwardsReceived: 1,
+
+		AllAwardings: []*Awarding{{Award: wholesome, Count: 1}},
+
+		CrosspostParent: "t3_j1ab2c",
+		CrosspostParentList: []*Post{
+			{
+				ID:      "j1ab2c",
+				FullID:  "t3_j1ab2c",
+				Created: &Timestamp{time.Date(2020, 9, 28, 6, 24, 5, 0, time.UTC)},
+				Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+
+				Permalink:       "/r/pics/comments/j1ab2c/sunset_over_the_lake/",
+				URL:             "https://i.redd.it/9r8f2mxk3qp51.jpg",
+				Thumbnail:       "https://b.thumbs.redditmedia.com/q7W3pZk9xL2vR5tY8uB1nM4cF6hJ0sD3gK7aE2iO9lQ.jpg",
+				ThumbnailWidth:  140,
+				ThumbnailHeight: 93,
+				PostHint:        "image",
+
+				Title: "Sunset over the lake",
+
+				LinkFlairTextColor: "dark",
+
+				Score:               5321,
+				UpvoteRatio:         0.97,
+				NumberOfComments:    87,
+				NumberOfCrossposts:  1,
+				TotalAwardsReceived: 3,
+
+				AllAwardings: []*Awarding{
+					{Award: helpful, Count: 2},
+					{Award: wholesome, Count: 1},
+				},
+
+				SubredditName:         "pics",
+				SubredditNamePrefixed: "r/pics",
+				SubredditID:           "t5_2qh0u",
+				SubredditSubscribers:  28314755,
+
+				Author:   "testuser2",
+				AuthorID: "t2_testuser2",
+
+				IsCrosspostable: true,
+
+				Preview: preview,
+			},
+		},
+
+		Collections: []*Collection{
+			{
+				ID:      "37f1e52d-7ec9-466b-b4cc-59e86e071ed7",
+				Created: &Timestamp{time.Date(2020, 9, 28, 7, 26, 40, 0, time.UTC)},
+				Updated: &Timestamp{time.Date(2020, 9, 28, 7, 43, 20, 0, time.UTC)},
+
+				Title:       "Photos",
+				Description: "The best photos posted here.",
+				Permalink:   "https://www.reddit.com/r/test/collection/37f1e52d-7ec9-466b-b4cc-59e86e071ed7",
+				Layout:      "GALLERY",
+
+				SubredditID: "t5_2qh23",
+				Author:      "v_95",
+				AuthorID:    "t2_164ab8",
+
+				PostIDs: []string{"t3_j1cd3e", "t3_hyhquk"},
+			},
+		},
+
+		SubredditName:         "test",
+		SubredditNamePrefixed: "r/test",
+		SubredditID:           "t5_2qh23",
+		SubredditSubscribers:  8280,
+
+		Author:   "testuser",
+		AuthorID: "t2_testuser",
+
+		Stickied:        true,
+		IsCrosspostable: true,
+
+		Preview: preview,
+	}, postAndComments.Post)
+}
+
+func TestPostService_GetWithOptions(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/post/post.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/comments/abc123", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "10")
+		form.Set("depth", "2")
+		form.Set("sort", "new")
+		form.Set("comment", "def456")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	postAndComments, _, err := client.Post.GetWithOptions(ctx, "abc123", &ListPostCommentsOptions{
+		Limit:   10,
+		Depth:   2,
+		Sort:    "new",
+		Comment: "def456",
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedPostAndComments, postAndComments)
+	require.NotEmpty(t, postAndComments.Comments[0].Replies.Comments)
+}
+
 func TestPostService_Duplicates(t *testing.T) {
 	client, mux := setup(t)
 
@@ -293,6 +518,55 @@ func TestPostService_SubmitText(t *testing.T) {
 	require.Equal(t, expectedSubmittedPost, submittedPost)
 }
 
+func TestPostService_SubmitText_Richtext(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/post/submit.json")
+	require.NoError(t, err)
+
+	richtext := `{"document":[{"e":"par","c":[{"e":"text","t":"Test Text"}]}]}`
+
+	mux.HandleFunc("/api/submit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("kind", "self")
+		form.Set("sr", "test")
+		form.Set("title", "Test Title")
+		form.Set("richtext_json", richtext)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Post.SubmitText(ctx, SubmitTextRequest{
+		Subreddit:    "test",
+		Title:        "Test Title",
+		RichtextJSON: `{"document": [`,
+	})
+	require.EqualError(t, err, "RichtextJSON: must be valid JSON")
+
+	_, _, err = client.Post.SubmitText(ctx, SubmitTextRequest{
+		Subreddit:    "test",
+		Title:        "Test Title",
+		Text:         "Test Text",
+		RichtextJSON: richtext,
+	})
+	require.EqualError(t, err, "only one of Text or RichtextJSON may be set")
+
+	submittedPost, _, err := client.Post.SubmitText(ctx, SubmitTextRequest{
+		Subreddit:    "test",
+		Title:        "Test Title",
+		RichtextJSON: richtext,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedSubmittedPost, submittedPost)
+}
+
 func TestPostService_SubmitLink(t *testing.T) {
 	client, mux := setup(t)
 
@@ -331,6 +605,218 @@ func TestPostService_SubmitLink(t *testing.T) {
 	require.Equal(t, expectedSubmittedPost, submittedPost)
 }
 
+func TestPostService_SubmitGallery(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/post/submit-gallery.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/submit_gallery_post.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{
+			"api_type":    "json",
+			"sr":          "test",
+			"title":       "Test Title",
+			"sendreplies": false,
+			"nsfw":        false,
+			"spoiler":     true,
+			"items": []interface{}{
+				map[string]interface{}{"media_id": "media1", "caption": "first"},
+				map[string]interface{}{"media_id": "media2", "outbound_url": "https://www.example.com"},
+			},
+		}, body)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Post.SubmitGallery(ctx, SubmitGalleryRequest{
+		Items: []GalleryItem{{MediaID: "media1"}},
+	})
+	require.EqualError(t, err, "gallery must contain between 2 and 20 items")
+
+	_, _, err = client.Post.SubmitGallery(ctx, SubmitGalleryRequest{
+		Items: []GalleryItem{{MediaID: "media1"}, {Caption: "no media"}},
+	})
+	require.EqualError(t, err, "gallery item media id: cannot be empty")
+
+	submittedPost, _, err := client.Post.SubmitGallery(ctx, SubmitGalleryRequest{
+		Subreddit:   "test",
+		Title:       "Test Title",
+		SendReplies: Bool(false),
+		Spoiler:     true,
+		Items: []GalleryItem{
+			{MediaID: "media1", Caption: "first"},
+			{MediaID: "media2", OutboundURL: "https://www.example.com"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &Submitted{
+		ID:     "hw6l6a",
+		FullID: "t3_hw6l6a",
+		URL:    "https://www.reddit.com/gallery/hw6l6a",
+	}, submittedPost)
+}
+
+func TestPostService_SubmitVideo(t *testing.T) {
+	client, mux := setup(t)
+
+	uploadURL := client.BaseURL.Host + "/api/video_upload"
+
+	blob, err := readFileContents("../testdata/post/lease.json")
+	require.NoError(t, err)
+	blob = fmt.Sprintf(blob, uploadURL)
+
+	submitBlob, err := readFileContents("../testdata/post/submit.json")
+	require.NoError(t, err)
+
+	videoFile, err := ioutil.TempFile("/tmp", "video*.mp4")
+	require.NoError(t, err)
+	defer func() {
+		videoFile.Close()
+		os.Remove(videoFile.Name())
+	}()
+
+	_, err = videoFile.WriteString("this is a test")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/media/asset.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("filepath", filepath.Base(videoFile.Name()))
+		form.Set("mimetype", "video/mp4")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	mux.HandleFunc("/api/video_upload", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		_, file, err := r.FormFile("file")
+		require.NoError(t, err)
+
+		rdr, err := file.Open()
+		require.NoError(t, err)
+
+		buf := new(bytes.Buffer)
+		_, err = io.Copy(buf, rdr)
+		require.NoError(t, err)
+		require.Equal(t, "this is a test", buf.String())
+
+		form := url.Values{}
+		form.Set("key", "rte_images/test_video_key")
+		form.Set("test name", "test value")
+
+		err = r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	mux.HandleFunc("/api/submit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("kind", "videogif")
+		form.Set("sr", "test")
+		form.Set("title", "Test Title")
+		form.Set("url", fmt.Sprintf("%s/api/video_upload/rte_images/test_video_key", client.BaseURL))
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, submitBlob)
+	})
+
+	submittedPost, _, err := client.Post.SubmitVideo(ctx, SubmitVideoRequest{
+		Subreddit: "test",
+		Title:     "Test Title",
+		GIF:       true,
+	}, videoFile.Name())
+	require.NoError(t, err)
+	require.Equal(t, expectedSubmittedPost, submittedPost)
+}
+
+func TestPostService_SubmitPoll(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/post/submit.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/submit_poll_post.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+
+		require.Equal(t, "json", body["api_type"])
+		require.Equal(t, "test", body["sr"])
+		require.Equal(t, "Test Title", body["title"])
+		require.Equal(t, []interface{}{"Yes", "No"}, body["options"])
+		require.Equal(t, float64(3), body["duration"])
+
+		var richtextJSON map[string]interface{}
+		err = json.Unmarshal([]byte(body["richtext_json"].(string)), &richtextJSON)
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{
+			"document": []interface{}{
+				map[string]interface{}{
+					"e": "par",
+					"c": []interface{}{
+						map[string]interface{}{"e": "text", "t": "Is this a test?"},
+					},
+				},
+			},
+		}, richtextJSON)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Post.SubmitPoll(ctx, SubmitPollRequest{
+		Options:      []PollOption{{"Yes"}},
+		DurationDays: 3,
+	})
+	require.EqualError(t, err, "poll must have between 2 and 6 options")
+
+	_, _, err = client.Post.SubmitPoll(ctx, SubmitPollRequest{
+		Options:      []PollOption{{"Yes"}, {"No"}, {"Maybe"}, {"Sometimes"}, {"Never"}, {"Always"}, {"Often"}},
+		DurationDays: 3,
+	})
+	require.EqualError(t, err, "poll must have between 2 and 6 options")
+
+	_, _, err = client.Post.SubmitPoll(ctx, SubmitPollRequest{
+		Options:      []PollOption{{"Yes"}, {"this option is way too long to be valid"}},
+		DurationDays: 3,
+	})
+	require.EqualError(t, err, `poll option "this option is way too long to be valid": cannot be longer than 25 characters`)
+
+	_, _, err = client.Post.SubmitPoll(ctx, SubmitPollRequest{
+		Options:      []PollOption{{"Yes"}, {"No"}},
+		DurationDays: 8,
+	})
+	require.EqualError(t, err, "poll duration must be between 1 and 7 days")
+
+	submittedPost, _, err := client.Post.SubmitPoll(ctx, SubmitPollRequest{
+		Subreddit:    "test",
+		Title:        "Test Title",
+		Question:     "Is this a test?",
+		Options:      []PollOption{{"Yes"}, {"No"}},
+		DurationDays: 3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedSubmittedPost, submittedPost)
+}
+
 func TestPostService_Edit(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1093,6 +1579,79 @@ func TestPostService_RemoveVote(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestPostService_BatchVote(t *testing.T) {
+	client, mux := setup(t)
+
+	var events []string
+
+	defaultSleep := sleep
+	sleep = func(ctx context.Context, d time.Duration) error {
+		events = append(events, fmt.Sprintf("sleep %s", d))
+		return nil
+	}
+	defer func() { sleep = defaultSleep }()
+
+	mux.HandleFunc("/api/vote", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "10", r.PostForm.Get("rank"))
+
+		events = append(events, fmt.Sprintf("vote %s %s", r.PostForm.Get("id"), r.PostForm.Get("dir")))
+
+		if r.PostForm.Get("id") == "t3_forbidden" {
+			w.WriteHeader(http.StatusForbidden)
+		}
+	})
+
+	errs, err := client.Post.BatchVote(ctx, []VoteRequest{
+		{ID: "t3_test1", Direction: 1},
+		{ID: "t3_test2", Direction: 5},
+		{ID: "t3_forbidden", Direction: -1},
+		{ID: "t3_test3", Direction: 0},
+	})
+	require.NoError(t, err)
+	require.Len(t, errs, 4)
+	require.NoError(t, errs[0])
+	require.EqualError(t, errs[1], "vote direction 5: must be one of -1, 0, 1")
+	require.IsType(t, &ErrorResponse{}, errs[2])
+	require.NoError(t, errs[3])
+
+	require.Equal(t, []string{
+		"vote t3_test1 1",
+		"sleep 2s",
+		"sleep 2s",
+		"vote t3_forbidden -1",
+		"sleep 2s",
+		"vote t3_test3 0",
+	}, events)
+}
+
+func TestPostService_BatchVote_ContextDone(t *testing.T) {
+	client, mux := setup(t)
+
+	defaultSleep := sleep
+	sleep = func(ctx context.Context, d time.Duration) error {
+		return context.Canceled
+	}
+	defer func() { sleep = defaultSleep }()
+
+	var counter int
+	mux.HandleFunc("/api/vote", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		counter++
+	})
+
+	errs, err := client.Post.BatchVote(ctx, []VoteRequest{
+		{ID: "t3_test1", Direction: 1},
+		{ID: "t3_test2", Direction: 1},
+	})
+	require.Equal(t, context.Canceled, err)
+	require.Equal(t, []error{nil, nil}, errs)
+	require.Equal(t, 1, counter)
+}
+
 func TestPostService_MarkVisited(t *testing.T) {
 	client, mux := setup(t)
 
@@ -0,0 +1,138 @@
+package reddit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// PredictionService handles communication with the prediction
+// related methods of the Reddit API.
+// Predictions let users of a subreddit bet on the outcome of an event.
+type PredictionService struct {
+	client *Client
+}
+
+// Prediction is a question users of a subreddit can predict the outcome of.
+type Prediction struct {
+	ID      string     `json:"id,omitempty"`
+	PostID  string     `json:"post_id,omitempty"`
+	Created *Timestamp `json:"created_utc,omitempty"`
+	// The time after which predictions can no longer be made.
+	EndsAt *Timestamp `json:"ends_at,omitempty"`
+
+	Title   string              `json:"title,omitempty"`
+	Options []*PredictionOption `json:"options,omitempty"`
+	// Empty until the prediction is resolved.
+	WinningOptionID string `json:"resolved_option_id,omitempty"`
+
+	TotalVoteCount int  `json:"total_vote_count"`
+	IsResolved     bool `json:"is_resolved"`
+}
+
+// PredictionOption is one of the possible outcomes of a prediction.
+type PredictionOption struct {
+	ID         string `json:"id,omitempty"`
+	Text       string `json:"text,omitempty"`
+	VoteCount  int    `json:"vote_count"`
+	TotalStake int    `json:"total_stake"`
+}
+
+// PredictionLeaderboardEntry is a user's standing in a subreddit's prediction leaderboard.
+type PredictionLeaderboardEntry struct {
+	Rank     int    `json:"rank"`
+	Username string `json:"username,omitempty"`
+	// The number of predictions the user got right.
+	Correct int `json:"correct_count"`
+	// The number of predictions the user made.
+	Total int `json:"total_count"`
+}
+
+// PredictionCreateRequest represents a request to create a prediction.
+type PredictionCreateRequest struct {
+	Title string `url:"title"`
+	// Between 2 and 6 options.
+	Options []string `url:"options"`
+	// The time after which predictions can no longer be made. Must be in the future.
+	EndsAt time.Time `url:"-"`
+}
+
+func (r *PredictionCreateRequest) validate() error {
+	if r.Title == "" {
+		return errors.New("(*PredictionCreateRequest).Title: cannot be empty")
+	}
+	if len(r.Options) < 2 || len(r.Options) > 6 {
+		return errors.New("(*PredictionCreateRequest).Options: must provide between 2 and 6 options")
+	}
+	for i, option := range r.Options {
+		if option == "" {
+			return fmt.Errorf("(*PredictionCreateRequest).Options[%d]: cannot be empty", i)
+		}
+	}
+	if !r.EndsAt.After(time.Now()) {
+		return errors.New("(*PredictionCreateRequest).EndsAt: must be in the future")
+	}
+	return nil
+}
+
+// Leaderboard returns the users who made the most correct predictions in the subreddit.
+func (s *PredictionService) Leaderboard(ctx context.Context, subreddit string) ([]*PredictionLeaderboardEntry, *Response, error) {
+	path := fmt.Sprintf("r/%s/api/prediction_leaderboard", subreddit)
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Entries []*PredictionLeaderboardEntry `json:"leaderboard"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Entries, resp, nil
+}
+
+// Create a prediction in the subreddit and get its id.
+// Requires the "posts" moderator permission.
+func (s *PredictionService) Create(ctx context.Context, subreddit string, request *PredictionCreateRequest) (string, *Response, error) {
+	if request == nil {
+		return "", nil, errors.New("*PredictionCreateRequest: cannot be nil")
+	}
+	if err := request.validate(); err != nil {
+		return "", nil, err
+	}
+
+	form, err := query.Values(request)
+	if err != nil {
+		return "", nil, err
+	}
+	form.Set("ends_at", strconv.FormatInt(request.EndsAt.Unix(), 10))
+	form.Set("api_type", "json")
+
+	path := fmt.Sprintf("r/%s/api/create_prediction", subreddit)
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return "", nil, err
+	}
+
+	root := new(struct {
+		JSON struct {
+			Data struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		} `json:"json"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return root.JSON.Data.ID, resp, nil
+}
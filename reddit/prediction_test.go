@@ -0,0 +1,83 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPredictionService_Leaderboard(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/prediction/leaderboard.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/api/prediction_leaderboard", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	entries, _, err := client.Prediction.Leaderboard(ctx, "testsubreddit")
+	require.NoError(t, err)
+	require.Equal(t, []*PredictionLeaderboardEntry{
+		{Rank: 1, Username: "testuser", Correct: 14, Total: 17},
+		{Rank: 2, Username: "testuser2", Correct: 11, Total: 19},
+	}, entries)
+}
+
+func TestPredictionService_Create(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/create_prediction", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("title", "Who wins the finals?")
+		form.Add("options", "Lakers")
+		form.Add("options", "Heat")
+		form.Set("ends_at", "1893542400")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, `{
+			"json": {
+				"data": {
+					"id": "Prediction_1f0e9d8c-7b6a-4a5b-9c8d-2e3f4a5b6c7d"
+				},
+				"errors": []
+			}
+		}`)
+	})
+
+	_, _, err := client.Prediction.Create(ctx, "testsubreddit", nil)
+	require.EqualError(t, err, "*PredictionCreateRequest: cannot be nil")
+
+	endsAt := time.Now().Add(time.Hour)
+
+	_, _, err = client.Prediction.Create(ctx, "testsubreddit", &PredictionCreateRequest{Options: []string{"a", "b"}, EndsAt: endsAt})
+	require.EqualError(t, err, "(*PredictionCreateRequest).Title: cannot be empty")
+
+	_, _, err = client.Prediction.Create(ctx, "testsubreddit", &PredictionCreateRequest{Title: "test", Options: []string{"a"}, EndsAt: endsAt})
+	require.EqualError(t, err, "(*PredictionCreateRequest).Options: must provide between 2 and 6 options")
+
+	_, _, err = client.Prediction.Create(ctx, "testsubreddit", &PredictionCreateRequest{Title: "test", Options: []string{"a", ""}, EndsAt: endsAt})
+	require.EqualError(t, err, "(*PredictionCreateRequest).Options[1]: cannot be empty")
+
+	_, _, err = client.Prediction.Create(ctx, "testsubreddit", &PredictionCreateRequest{Title: "test", Options: []string{"a", "b"}})
+	require.EqualError(t, err, "(*PredictionCreateRequest).EndsAt: must be in the future")
+
+	id, _, err := client.Prediction.Create(ctx, "testsubreddit", &PredictionCreateRequest{
+		Title:   "Who wins the finals?",
+		Options: []string{"Lakers", "Heat"},
+		EndsAt:  time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Prediction_1f0e9d8c-7b6a-4a5b-9c8d-2e3f4a5b6c7d", id)
+}
@@ -0,0 +1,163 @@
+package reddit
+
+import (
+	"bytes"
+	"container/list"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type cachedResponse struct {
+	key       string
+	createdAt time.Time
+
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+// responseCache is an LRU cache of responses, keyed by URL.
+type responseCache struct {
+	maxAge     time.Duration
+	maxEntries int
+
+	// used to get the current time, can be swapped in tests
+	now func() time.Time
+
+	mu      sync.Mutex
+	ll      *list.List
+	entries map[string]*list.Element
+}
+
+func newResponseCache(maxAge time.Duration, maxEntries int) *responseCache {
+	return &responseCache{
+		maxAge:     maxAge,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		ll:         list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+func (c *responseCache) get(key string) (*cachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*cachedResponse)
+	if c.now().Sub(entry.createdAt) >= c.maxAge {
+		c.ll.Remove(el)
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	return entry, true
+}
+
+func (c *responseCache) add(entry *cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[entry.key]; ok {
+		el.Value = entry
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.entries[entry.key] = c.ll.PushFront(entry)
+	if c.ll.Len() > c.maxEntries {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedResponse).key)
+	}
+}
+
+func (c *responseCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ll.Init()
+	c.entries = make(map[string]*list.Element)
+}
+
+// Serves GET requests from the cache when possible, and caches successful responses.
+// Responses served from the cache have the X-From-Cache header set.
+type cacheTransport struct {
+	cache *responseCache
+	Base  http.RoundTripper
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.base().RoundTrip(req)
+	}
+
+	key := req.URL.String()
+	if entry, ok := t.cache.get(key); ok {
+		header := entry.header.Clone()
+		header.Set(headerFromCache, "1")
+
+		return &http.Response{
+			Status:        http.StatusText(entry.statusCode),
+			StatusCode:    entry.statusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(entry.body)),
+			ContentLength: int64(len(entry.body)),
+			Request:       req,
+		}, nil
+	}
+
+	resp, err := t.base().RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	// The rate limit headers only describe the moment the response was received,
+	// so they aren't replayed with it.
+	header := resp.Header.Clone()
+	header.Del(headerRateLimitRemaining)
+	header.Del(headerRateLimitUsed)
+	header.Del(headerRateLimitReset)
+
+	t.cache.add(&cachedResponse{
+		key:        key,
+		createdAt:  t.cache.now(),
+		statusCode: resp.StatusCode,
+		header:     header,
+		body:       body,
+	})
+
+	return resp, nil
+}
+
+func (t *cacheTransport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// InvalidateCache removes all the responses cached by the client.
+// It has no effect if the client wasn't created with WithCache.
+func (c *Client) InvalidateCache() {
+	if c.cache != nil {
+		c.cache.purge()
+	}
+}
@@ -0,0 +1,72 @@
+package reddit
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsCollector holds the Prometheus metrics recorded for the requests made by a client.
+// The same collector can be shared by multiple clients via WithMetricsCollector.
+type MetricsCollector struct {
+	requestDuration    *prometheus.HistogramVec
+	requestsTotal      *prometheus.CounterVec
+	rateLimitRemaining prometheus.Gauge
+}
+
+func newMetricsCollector() *MetricsCollector {
+	labels := []string{"method", "status_code"}
+	return &MetricsCollector{
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "reddit_request_duration_seconds",
+			Help:    "Duration of requests made to the Reddit API.",
+			Buckets: prometheus.DefBuckets,
+		}, labels),
+		requestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "reddit_requests_total",
+			Help: "Number of requests made to the Reddit API.",
+		}, labels),
+		rateLimitRemaining: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "reddit_rate_limit_remaining",
+			Help: "Number of requests remaining in the current rate limit window.",
+		}),
+	}
+}
+
+// NewMetricsCollector returns a new collector whose metrics are registered with r.
+// Like prometheus.MustRegister, it panics if the metrics can't be registered.
+func NewMetricsCollector(r prometheus.Registerer) *MetricsCollector {
+	m := newMetricsCollector()
+	if err := m.register(r); err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func (m *MetricsCollector) register(r prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{m.requestDuration, m.requestsTotal, m.rateLimitRemaining} {
+		if err := r.Register(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// observe records the outcome of the request.
+func (m *MetricsCollector) observe(req *http.Request, resp *Response, duration time.Duration) {
+	if m == nil {
+		return
+	}
+
+	// Requests that didn't get a response (e.g. network errors) have no status code.
+	statusCode := "error"
+	if resp != nil && resp.Response != nil {
+		statusCode = strconv.Itoa(resp.StatusCode)
+		m.rateLimitRemaining.Set(float64(resp.Rate.Remaining))
+	}
+
+	m.requestDuration.WithLabelValues(req.Method, statusCode).Observe(duration.Seconds())
+	m.requestsTotal.WithLabelValues(req.Method, statusCode).Inc()
+}
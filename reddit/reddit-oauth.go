@@ -50,6 +50,21 @@ func (s *oauthTokenSource) Token() (*oauth2.Token, error) {
 	return s.config.PasswordCredentialsToken(s.ctx, s.username, s.password)
 }
 
+// Calls onRefresh with every new token fetched from the underlying token source.
+type notifyingTokenSource struct {
+	source    oauth2.TokenSource
+	onRefresh func(*oauth2.Token)
+}
+
+func (s *notifyingTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.source.Token()
+	if err != nil {
+		return nil, err
+	}
+	s.onRefresh(token)
+	return token, nil
+}
+
 func oauthTransport(client *Client) http.RoundTripper {
 	httpClient := &http.Client{Transport: client.client.Transport}
 	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
@@ -63,12 +78,19 @@ func oauthTransport(client *Client) http.RoundTripper {
 		},
 	}
 
-	tokenSource := oauth2.ReuseTokenSource(nil, &oauthTokenSource{
+	var source oauth2.TokenSource = &oauthTokenSource{
 		ctx:      ctx,
 		config:   config,
 		username: client.Username,
 		password: client.Password,
-	})
+	}
+	if client.onTokenRefresh != nil {
+		source = &notifyingTokenSource{source: source, onRefresh: client.onTokenRefresh}
+	}
+
+	// The reuse token source only asks the underlying source for a token when it
+	// doesn't have a valid one, so the callback is only invoked on refreshes.
+	tokenSource := oauth2.ReuseTokenSource(nil, source)
 
 	return &oauth2.Transport{
 		Source: tokenSource,
@@ -2,15 +2,24 @@ package reddit
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/oauth2"
 )
 
 // Opt is used to further configure a client upon initialization.
 type Opt func(*Client) error
 
 // WithHTTPClient sets the HTTP client which will be used to make requests.
+// The client's transport is wrapped to authenticate requests, so it should not
+// be configured with OAuth2 itself.
 func WithHTTPClient(httpClient *http.Client) Opt {
 	return func(c *Client) error {
 		if httpClient == nil {
@@ -32,21 +41,27 @@ func WithUserAgent(ua string) Opt {
 }
 
 // WithBaseURL sets the base URL for the client to make requests to.
+// It must be absolute, e.g. "https://oauth.reddit.com". If it has a path,
+// requests are made relative to it, e.g. "http://localhost:8080/reddit".
 func WithBaseURL(u string) Opt {
 	return func(c *Client) error {
-		url, err := url.Parse(u)
+		url, err := parseAbsoluteURL(u)
 		if err != nil {
 			return err
 		}
+		if url.Path != "" && !strings.HasSuffix(url.Path, "/") {
+			url.Path += "/"
+		}
 		c.BaseURL = url
 		return nil
 	}
 }
 
 // WithTokenURL sets the url used to get access tokens.
+// It must be absolute, e.g. "https://www.reddit.com/api/v1/access_token".
 func WithTokenURL(u string) Opt {
 	return func(c *Client) error {
-		url, err := url.Parse(u)
+		url, err := parseAbsoluteURL(u)
 		if err != nil {
 			return err
 		}
@@ -55,6 +70,116 @@ func WithTokenURL(u string) Opt {
 	}
 }
 
+func parseAbsoluteURL(u string) (*url.URL, error) {
+	url, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+	if !url.IsAbs() || url.Host == "" {
+		return nil, fmt.Errorf("%q: must be an absolute URL with a scheme and host", u)
+	}
+	return url, nil
+}
+
+// WithTracer sets the OpenTelemetry tracer provider used to trace requests.
+// A span named "reddit.request" is recorded for every request made by the client.
+func WithTracer(tp trace.TracerProvider) Opt {
+	return func(c *Client) error {
+		if tp == nil {
+			return errors.New("trace.TracerProvider: cannot be nil")
+		}
+		c.tracer = tp.Tracer(libraryName, trace.WithInstrumentationVersion(libraryVersion))
+		return nil
+	}
+}
+
+// WithMetrics registers Prometheus metrics about the client's requests with r.
+// The following metrics are recorded:
+// reddit_request_duration_seconds, a histogram of request durations, by method and status code.
+// reddit_requests_total, a counter of requests, by method and status code.
+// reddit_rate_limit_remaining, a gauge of the requests remaining in the current rate limit window.
+func WithMetrics(r prometheus.Registerer) Opt {
+	return func(c *Client) error {
+		if r == nil {
+			return errors.New("prometheus.Registerer: cannot be nil")
+		}
+		m := newMetricsCollector()
+		if err := m.register(r); err != nil {
+			return err
+		}
+		c.metrics = m
+		return nil
+	}
+}
+
+// WithMetricsCollector records metrics about the client's requests in the collector.
+// See NewMetricsCollector.
+func WithMetricsCollector(m *MetricsCollector) Opt {
+	return func(c *Client) error {
+		if m == nil {
+			return errors.New("*MetricsCollector: cannot be nil")
+		}
+		c.metrics = m
+		return nil
+	}
+}
+
+// WithRequestQueue limits the number of requests the client has in flight at once to maxConcurrent.
+// Once the limit is reached, requests wait until another one completes or their context is done.
+// Reddit recommends no more than one concurrent request per OAuth token.
+func WithRequestQueue(maxConcurrent int) Opt {
+	return func(c *Client) error {
+		if maxConcurrent < 1 {
+			return errors.New("maxConcurrent: must be at least 1")
+		}
+		c.maxConcurrentRequests = maxConcurrent
+		return nil
+	}
+}
+
+// WithOnTokenRefresh sets a function that's called with the new access token every time
+// the client gets one, e.g. so that it can be persisted.
+// It can't be used with read-only clients.
+func WithOnTokenRefresh(fn func(token *oauth2.Token)) Opt {
+	return func(c *Client) error {
+		if fn == nil {
+			return errors.New("fn: cannot be nil")
+		}
+		c.onTokenRefresh = fn
+		return nil
+	}
+}
+
+// WithCache caches the responses of successful GET requests in memory for maxAge, keyed by URL.
+// Identical requests made within that time get the cached response without hitting the Reddit API.
+// At most maxEntries responses are kept, evicting the least recently used ones first.
+// Use Client.InvalidateCache to clear the cache.
+func WithCache(maxAge time.Duration, maxEntries int) Opt {
+	return func(c *Client) error {
+		if maxAge <= 0 {
+			return errors.New("maxAge: must be positive")
+		}
+		if maxEntries < 1 {
+			return errors.New("maxEntries: must be at least 1")
+		}
+		c.cache = newResponseCache(maxAge, maxEntries)
+		return nil
+	}
+}
+
+// WithRequestIDGenerator sets a function used to generate an ID for every request made
+// with the client, sent in the X-Request-ID header. It can be used to correlate logs.
+// Requests that already have the header set keep their own ID.
+func WithRequestIDGenerator(fn func() string) Opt {
+	return func(c *Client) error {
+		if fn == nil {
+			return errors.New("fn: cannot be nil")
+		}
+		c.requestIDGenerator = fn
+		return nil
+	}
+}
+
 // FromEnv configures the client with values from environment variables.
 // Supported environment variables:
 // GO_REDDIT_CLIENT_ID to set the client's id.
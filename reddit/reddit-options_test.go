@@ -1,13 +1,27 @@
 package reddit
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"sort"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/oauth2"
 )
 
 func TestWithHTTPClient(t *testing.T) {
@@ -34,18 +48,50 @@ func TestWithBaseURL(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, "parse", urlErr.Op)
 
+	_, err = NewClient(Credentials{}, WithBaseURL("localhost:8080"))
+	require.EqualError(t, err, `"localhost:8080": must be an absolute URL with a scheme and host`)
+
+	_, err = NewClient(Credentials{}, WithBaseURL("/api"))
+	require.EqualError(t, err, `"/api": must be an absolute URL with a scheme and host`)
+
 	baseURL := "http://localhost:8080"
 	c, err = NewClient(Credentials{}, WithBaseURL(baseURL))
 	require.NoError(t, err)
 	require.Equal(t, baseURL, c.BaseURL.String())
 }
 
+func TestWithBaseURL_Path(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var called bool
+	mux.HandleFunc("/proxy/api/v1/me", func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	c, err := NewClient(Credentials{}, WithBaseURL(server.URL+"/proxy"))
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/proxy/", c.BaseURL.String())
+
+	req, err := c.NewRequest(http.MethodGet, "api/v1/me", nil)
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/proxy/api/v1/me", req.URL.String())
+
+	_, err = DoRequestWithClient(ctx, server.Client(), req)
+	require.NoError(t, err)
+	require.True(t, called)
+}
+
 func TestWithTokenURL(t *testing.T) {
 	c, err := NewClient(Credentials{}, WithTokenURL(":"))
 	urlErr, ok := err.(*url.Error)
 	require.True(t, ok)
 	require.Equal(t, "parse", urlErr.Op)
 
+	_, err = NewClient(Credentials{}, WithTokenURL("api/v1/access_token"))
+	require.EqualError(t, err, `"api/v1/access_token": must be an absolute URL with a scheme and host`)
+
 	tokenURL := "http://localhost:8080/api/v1/access_token"
 	c, err = NewClient(Credentials{}, WithTokenURL(tokenURL))
 	require.NoError(t, err)
@@ -72,3 +118,452 @@ func TestFromEnv(t *testing.T) {
 	require.Equal(t, "username1", c.Username)
 	require.Equal(t, "password1", c.Password)
 }
+
+func TestWithTracer(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithTracer(nil))
+	require.EqualError(t, err, "trace.TracerProvider: cannot be nil")
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/test.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+	})
+	mux.HandleFunc("/api/v1/error.json", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	c, err := NewReadonlyClient(WithBaseURL(server.URL), WithTracer(tp))
+	require.NoError(t, err)
+
+	req, err := c.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+	_, err = c.Do(ctx, req, nil)
+	require.NoError(t, err)
+
+	req, err = c.NewRequest(http.MethodGet, "api/v1/error", nil)
+	require.NoError(t, err)
+	_, err = c.Do(ctx, req, nil)
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	require.Equal(t, "reddit.request", spans[0].Name())
+	require.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	require.Contains(t, spans[0].Attributes(), attribute.String("http.method", http.MethodGet))
+	require.Contains(t, spans[0].Attributes(), attribute.String("http.path", "/api/v1/test.json"))
+	require.Contains(t, spans[0].Attributes(), attribute.Int("http.status_code", http.StatusOK))
+	require.Equal(t, codes.Unset, spans[0].Status().Code)
+
+	require.Equal(t, "reddit.request", spans[1].Name())
+	require.Contains(t, spans[1].Attributes(), attribute.Int("http.status_code", http.StatusForbidden))
+	require.Equal(t, codes.Error, spans[1].Status().Code)
+}
+
+func TestWithMetrics(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithMetrics(nil))
+	require.EqualError(t, err, "prometheus.Registerer: cannot be nil")
+
+	_, err = NewClient(Credentials{}, WithMetricsCollector(nil))
+	require.EqualError(t, err, "*MetricsCollector: cannot be nil")
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/test.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		w.Header().Set(headerRateLimitRemaining, "598")
+	})
+
+	registry := prometheus.NewRegistry()
+	c, err := NewReadonlyClient(WithBaseURL(server.URL), WithMetrics(registry))
+	require.NoError(t, err)
+
+	// the metrics are already registered
+	_, err = NewReadonlyClient(WithMetrics(registry))
+	require.Error(t, err)
+
+	for i := 0; i < 2; i++ {
+		req, err := c.NewRequest(http.MethodGet, "api/v1/test", nil)
+		require.NoError(t, err)
+		_, err = c.Do(ctx, req, nil)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, float64(2), testutil.ToFloat64(c.metrics.requestsTotal.WithLabelValues(http.MethodGet, "200")))
+	require.Equal(t, float64(598), testutil.ToFloat64(c.metrics.rateLimitRemaining))
+	require.Equal(t, 1, testutil.CollectAndCount(c.metrics.requestDuration))
+}
+
+func TestNewMetricsCollector(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m := NewMetricsCollector(registry)
+
+	require.Panics(t, func() {
+		NewMetricsCollector(registry)
+	})
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/test.json", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	c1, err := NewReadonlyClient(WithBaseURL(server.URL), WithMetricsCollector(m))
+	require.NoError(t, err)
+	c2, err := NewReadonlyClient(WithBaseURL(server.URL), WithMetricsCollector(m))
+	require.NoError(t, err)
+
+	for _, c := range []*Client{c1, c2} {
+		req, err := c.NewRequest(http.MethodGet, "api/v1/test", nil)
+		require.NoError(t, err)
+		_, err = c.Do(ctx, req, nil)
+		require.Error(t, err)
+	}
+
+	require.Equal(t, float64(2), testutil.ToFloat64(m.requestsTotal.WithLabelValues(http.MethodGet, "404")))
+}
+
+func TestWithMetrics_RateLimited(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var counter int
+	mux.HandleFunc("/api/v1/test.json", func(w http.ResponseWriter, r *http.Request) {
+		counter++
+	})
+
+	c, err := NewReadonlyClient(WithBaseURL(server.URL), WithMetrics(prometheus.NewRegistry()))
+	require.NoError(t, err)
+
+	c.rate.Remaining = 0
+	c.rate.Reset = time.Now().Add(time.Minute)
+
+	req, err := c.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+	_, err = c.Do(ctx, req, nil)
+	require.IsType(t, &RateLimitError{}, err)
+
+	// the request was rejected before being sent, so it isn't counted
+	require.Equal(t, 0, counter)
+	require.Equal(t, 0, testutil.CollectAndCount(c.metrics.requestsTotal))
+	require.Equal(t, 0, testutil.CollectAndCount(c.metrics.requestDuration))
+}
+
+func TestWithRequestQueue(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithRequestQueue(0))
+	require.EqualError(t, err, "maxConcurrent: must be at least 1")
+
+	type interval struct {
+		start, end time.Time
+	}
+
+	var mu sync.Mutex
+	var intervals []interval
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/test.json", func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		time.Sleep(5 * time.Millisecond)
+		end := time.Now()
+
+		mu.Lock()
+		intervals = append(intervals, interval{start, end})
+		mu.Unlock()
+	})
+
+	c, err := NewReadonlyClient(WithBaseURL(server.URL), WithRequestQueue(1))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := c.NewRequest(http.MethodGet, "api/v1/test", nil)
+			require.NoError(t, err)
+			_, err = c.Do(ctx, req, nil)
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, intervals, 10)
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].start.Before(intervals[j].start)
+	})
+	for i := 1; i < len(intervals); i++ {
+		require.False(t, intervals[i].start.Before(intervals[i-1].end), "requests %d and %d overlap", i-1, i)
+	}
+}
+
+func TestWithRequestQueue_ContextDone(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	mux.HandleFunc("/api/v1/test.json", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})
+
+	c, err := NewReadonlyClient(WithBaseURL(server.URL), WithRequestQueue(1))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		req, err := c.NewRequest(http.MethodGet, "api/v1/test", nil)
+		require.NoError(t, err)
+		_, err = c.Do(ctx, req, nil)
+		require.NoError(t, err)
+	}()
+	<-started
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	req, err := c.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+	_, err = c.Do(cancelledCtx, req, nil)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, context.Canceled))
+
+	close(release)
+	<-done
+}
+
+func TestWithOnTokenRefresh(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithOnTokenRefresh(nil))
+	require.EqualError(t, err, "fn: cannot be nil")
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tokenCount := 0
+	mux.HandleFunc("/api/v1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		tokenCount++
+
+		// the token expires right away, so a new one is fetched for every request
+		w.Header().Add(headerContentType, mediaTypeJSON)
+		fmt.Fprintf(w, `{
+			"access_token": "token%d",
+			"token_type": "bearer",
+			"expires_in": 1,
+			"scope": "*"
+		}`, tokenCount)
+	})
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, fmt.Sprintf("Bearer token%d", tokenCount), r.Header.Get("Authorization"))
+	})
+
+	var tokens []string
+	c, err := NewClient(
+		Credentials{"id1", "secret1", "user1", "password1"},
+		WithBaseURL(server.URL),
+		WithTokenURL(server.URL+"/api/v1/access_token"),
+		WithOnTokenRefresh(func(token *oauth2.Token) {
+			tokens = append(tokens, token.AccessToken)
+		}),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		req, err := c.NewRequest(http.MethodGet, "api/v1/test", nil)
+		require.NoError(t, err)
+		_, err = c.Do(ctx, req, nil)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, []string{"token1", "token2"}, tokens)
+}
+
+func TestWithCache(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithCache(0, 10))
+	require.EqualError(t, err, "maxAge: must be positive")
+
+	_, err = NewClient(Credentials{}, WithCache(time.Minute, 0))
+	require.EqualError(t, err, "maxEntries: must be at least 1")
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var count int
+	mux.HandleFunc("/api/v1/test.json", func(w http.ResponseWriter, r *http.Request) {
+		count++
+		fmt.Fprint(w, `{"count": `+fmt.Sprint(count)+`}`)
+	})
+
+	c, err := NewReadonlyClient(WithBaseURL(server.URL), WithCache(time.Minute, 10))
+	require.NoError(t, err)
+
+	now := time.Now()
+	c.cache.now = func() time.Time { return now }
+
+	get := func() int {
+		req, err := c.NewRequest(http.MethodGet, "api/v1/test", nil)
+		require.NoError(t, err)
+
+		var root struct {
+			Count int `json:"count"`
+		}
+		_, err = c.Do(ctx, req, &root)
+		require.NoError(t, err)
+		return root.Count
+	}
+
+	require.Equal(t, 1, get())
+	require.Equal(t, 1, get())
+	require.Equal(t, 1, count)
+
+	// the cached response expires
+	now = now.Add(time.Minute)
+	require.Equal(t, 2, get())
+	require.Equal(t, 2, get())
+	require.Equal(t, 2, count)
+
+	c.InvalidateCache()
+	require.Equal(t, 3, get())
+	require.Equal(t, 3, count)
+}
+
+func TestWithCache_Eviction(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var count int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		count++
+		// only successful responses are cached
+		if r.URL.Path == "/api/v1/error.json" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	c, err := NewReadonlyClient(WithBaseURL(server.URL), WithCache(time.Minute, 2))
+	require.NoError(t, err)
+
+	do := func(method, path string) {
+		req, err := c.NewRequest(method, path, nil)
+		require.NoError(t, err)
+		_, _ = c.Do(ctx, req, nil)
+	}
+
+	do(http.MethodGet, "api/v1/a")
+	do(http.MethodGet, "api/v1/b")
+	do(http.MethodGet, "api/v1/a")
+	require.Equal(t, 2, count)
+
+	// b is the least recently used, so it's evicted
+	do(http.MethodGet, "api/v1/c")
+	do(http.MethodGet, "api/v1/a")
+	require.Equal(t, 3, count)
+	do(http.MethodGet, "api/v1/b")
+	require.Equal(t, 4, count)
+
+	do(http.MethodPost, "api/v1/a")
+	do(http.MethodPost, "api/v1/a")
+	require.Equal(t, 6, count)
+
+	do(http.MethodGet, "api/v1/error")
+	do(http.MethodGet, "api/v1/error")
+	require.Equal(t, 8, count)
+}
+
+func TestWithCache_RateLimit(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var count int
+	mux.HandleFunc("/api/v1/test.json", func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.Header().Set(headerRateLimitRemaining, "100")
+		w.Header().Set(headerRateLimitUsed, "500")
+		w.Header().Set(headerRateLimitReset, "120")
+	})
+
+	c, err := NewReadonlyClient(WithBaseURL(server.URL), WithCache(time.Minute, 10))
+	require.NoError(t, err)
+
+	req, err := c.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+	_, err = c.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	require.Equal(t, 100, c.rate.Remaining)
+
+	// other requests have been made since
+	rate := Rate{Remaining: 50, Used: 550, Reset: time.Now().Add(time.Minute).Truncate(time.Second)}
+	c.rate = rate
+
+	req, err = c.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+	resp, err := c.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Equal(t, "1", resp.Header.Get(headerFromCache))
+	require.Equal(t, Rate{}, resp.Rate)
+
+	// the cache hit neither uses up a request nor overwrites the rate limit
+	require.Equal(t, rate, c.rate)
+}
+
+func TestWithRequestIDGenerator(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithRequestIDGenerator(nil))
+	require.EqualError(t, err, "fn: cannot be nil")
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var ids []string
+	mux.HandleFunc("/api/v1/test.json", func(w http.ResponseWriter, r *http.Request) {
+		ids = append(ids, r.Header.Get("X-Request-ID"))
+	})
+
+	var n int
+	c, err := NewReadonlyClient(WithBaseURL(server.URL), WithRequestIDGenerator(func() string {
+		n++
+		return fmt.Sprintf("request-%d", n)
+	}))
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		req, err := c.NewRequest(http.MethodGet, "api/v1/test", nil)
+		require.NoError(t, err)
+
+		_, err = c.Do(ctx, req, nil)
+		require.NoError(t, err)
+	}
+
+	// an ID set by the caller is kept
+	req, err := c.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Request-ID", "custom")
+
+	_, err = c.Do(ctx, req, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"request-1", "request-2", "custom"}, ids)
+}
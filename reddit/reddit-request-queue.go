@@ -0,0 +1,62 @@
+package reddit
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+// Limits the number of requests in flight at once.
+// Reddit recommends no more than one concurrent request per OAuth token.
+type requestQueueTransport struct {
+	sem  chan struct{}
+	Base http.RoundTripper
+}
+
+func newRequestQueueTransport(maxConcurrent int, base http.RoundTripper) *requestQueueTransport {
+	return &requestQueueTransport{
+		sem:  make(chan struct{}, maxConcurrent),
+		Base: base,
+	}
+}
+
+func (t *requestQueueTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.sem <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	resp, err := t.base().RoundTrip(req)
+	if err != nil {
+		t.release()
+		return nil, err
+	}
+
+	// The request is only done once its response body has been read and closed.
+	resp.Body = &releaseOnCloseBody{ReadCloser: resp.Body, release: t.release}
+	return resp, nil
+}
+
+func (t *requestQueueTransport) release() {
+	<-t.sem
+}
+
+func (t *requestQueueTransport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
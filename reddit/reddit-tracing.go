@@ -0,0 +1,38 @@
+package reddit
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const spanNameRequest = "reddit.request"
+
+// startSpan starts the span tracing the request.
+// Unless a tracer was provided via WithTracer, the span does nothing.
+func (c *Client) startSpan(ctx context.Context, req *http.Request) (context.Context, trace.Span) {
+	return c.tracer.Start(ctx, spanNameRequest,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.method", req.Method),
+			attribute.String("http.path", req.URL.Path),
+		),
+	)
+}
+
+// endSpan records the outcome of the request on the span and ends it.
+func endSpan(span trace.Span, resp *Response, err error) {
+	defer span.End()
+
+	if resp != nil && resp.Response != nil {
+		span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
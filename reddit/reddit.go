@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -16,6 +17,7 @@ import (
 	"time"
 
 	"github.com/google/go-querystring/query"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/oauth2"
 )
 
@@ -33,17 +35,58 @@ const (
 	headerContentType = "Content-Type"
 	headerAccept      = "Accept"
 	headerUserAgent   = "User-Agent"
+	headerRequestID   = "X-Request-ID"
 
 	headerRateLimitRemaining = "x-ratelimit-remaining"
 	headerRateLimitUsed      = "x-ratelimit-used"
 	headerRateLimitReset     = "x-ratelimit-reset"
+
+	// Set on responses served by the cache instead of the Reddit API.
+	headerFromCache = "X-From-Cache"
+
+	// The number of bytes of the response body kept in an ErrorResponse.
+	maxBodySnippetLength = 512
 )
 
-var defaultClient, _ = NewReadonlyClient()
+// lazyClient is a client that's only created the first time it's needed.
+type lazyClient struct {
+	once   sync.Once
+	client *Client
+}
+
+func (l *lazyClient) get() *Client {
+	l.once.Do(func() {
+		// NewReadonlyClient can only fail because of its options, and none are given.
+		client, err := NewReadonlyClient()
+		if err != nil {
+			panic(fmt.Sprintf("reddit: could not create the default client: %v", err))
+		}
+		l.client = client
+	})
+	return l.client
+}
+
+var (
+	defaultClientMu sync.Mutex
+	defaultClient   = new(lazyClient)
+)
 
 // DefaultClient returns a valid, read-only client with limited access to the Reddit API.
+// The client is created on the first call, and the same one is returned afterwards.
 func DefaultClient() *Client {
-	return defaultClient
+	defaultClientMu.Lock()
+	l := defaultClient
+	defaultClientMu.Unlock()
+
+	return l.get()
+}
+
+// ResetDefaultClient discards the client returned by DefaultClient, so that the next
+// call creates a new one. This is mostly useful for tests.
+func ResetDefaultClient() {
+	defaultClientMu.Lock()
+	defaultClient = new(lazyClient)
+	defaultClientMu.Unlock()
 }
 
 // RequestCompletionCallback defines the type of the request callback function.
@@ -65,6 +108,9 @@ type Client struct {
 	BaseURL  *url.URL
 	TokenURL *url.URL
 
+	// The base URL of a read-only client, set when it's created.
+	readonlyURL *url.URL
+
 	userAgent string
 
 	rateMu sync.Mutex
@@ -78,27 +124,39 @@ type Client struct {
 	// This is the client's user ID in Reddit's database.
 	redditID string
 
-	Account    *AccountService
-	Collection *CollectionService
-	Comment    *CommentService
-	Emoji      *EmojiService
-	Flair      *FlairService
-	Gold       *GoldService
-	Listings   *ListingsService
-	LiveThread *LiveThreadService
-	Message    *MessageService
-	Moderation *ModerationService
-	Multi      *MultiService
-	Post       *PostService
-	Stream     *StreamService
-	Subreddit  *SubredditService
-	User       *UserService
-	Widget     *WidgetService
-	Wiki       *WikiService
+	Account       *AccountService
+	Chat          *ChatService
+	Collection    *CollectionService
+	Comment       *CommentService
+	Emoji         *EmojiService
+	Flair         *FlairService
+	Gold          *GoldService
+	Listings      *ListingsService
+	LiveThread    *LiveThreadService
+	Message       *MessageService
+	Moderation    *ModerationService
+	Multi         *MultiService
+	Post          *PostService
+	Prediction    *PredictionService
+	ScheduledPost *ScheduledPostService
+	Stream        *StreamService
+	Subreddit     *SubredditService
+	User          *UserService
+	Widget        *WidgetService
+	Wiki          *WikiService
 
 	oauth2Transport *oauth2.Transport
+	onTokenRefresh  func(*oauth2.Token)
 
 	onRequestCompleted RequestCompletionCallback
+
+	tracer  trace.Tracer
+	metrics *MetricsCollector
+
+	maxConcurrentRequests int
+	cache                 *responseCache
+
+	requestIDGenerator func() string
 }
 
 // OnRequestCompleted sets the client's request completion callback.
@@ -111,8 +169,10 @@ func newClient() *Client {
 	tokenURL, _ := url.Parse(defaultTokenURL)
 
 	client := &Client{client: &http.Client{}, BaseURL: baseURL, TokenURL: tokenURL}
+	client.tracer = trace.NewNoopTracerProvider().Tracer(libraryName)
 
 	client.Account = &AccountService{client: client}
+	client.Chat = &ChatService{client: client}
 	client.Collection = &CollectionService{client: client}
 	client.Emoji = &EmojiService{client: client}
 	client.Flair = &FlairService{client: client}
@@ -122,6 +182,8 @@ func newClient() *Client {
 	client.Message = &MessageService{client: client}
 	client.Moderation = &ModerationService{client: client}
 	client.Multi = &MultiService{client: client}
+	client.Prediction = &PredictionService{client: client}
+	client.ScheduledPost = &ScheduledPostService{client: client}
 	client.Stream = &StreamService{client: client}
 	client.Subreddit = &SubredditService{client: client}
 	client.User = &UserService{client: client}
@@ -153,6 +215,8 @@ func NewClient(credentials Credentials, opts ...Opt) (*Client, error) {
 		}
 	}
 
+	client.setDefaultUserAgent()
+
 	userAgentTransport := &userAgentTransport{
 		userAgent: client.UserAgent(),
 		Base:      client.client.Transport,
@@ -162,16 +226,21 @@ func NewClient(credentials Credentials, opts ...Opt) (*Client, error) {
 	if client.client.CheckRedirect == nil {
 		client.client.CheckRedirect = client.redirect
 	}
+	client.wrapCheckRedirect()
 
 	oauthTransport := oauthTransport(client)
 	client.client.Transport = oauthTransport
 
+	client.wrapTransport()
+
 	return client, nil
 }
 
 // NewReadonlyClient returns a new read-only Reddit API client.
 // The client will have limited access to the Reddit API.
-// Options that modify credentials (such as FromEnv) won't have any effect on this client.
+// Its requests are never authenticated, so options that only apply to authenticated
+// clients (such as FromEnv setting credentials, or WithOnTokenRefresh) result in an error.
+// If WithHTTPClient is used, the provided client should not be configured with OAuth2 itself.
 func NewReadonlyClient(opts ...Opt) (*Client, error) {
 	client := newClient()
 	client.BaseURL, _ = url.Parse(defaultBaseURLReadonly)
@@ -182,19 +251,68 @@ func NewReadonlyClient(opts ...Opt) (*Client, error) {
 		}
 	}
 
+	if client.ID != "" || client.Secret != "" || client.Username != "" || client.Password != "" {
+		return nil, errors.New("read-only client cannot be configured with credentials")
+	}
+	if client.onTokenRefresh != nil {
+		return nil, errors.New("read-only client cannot be configured with a token refresh callback")
+	}
+
+	// Requests made to the base URL need the .json extension, see appendJSONExtensionToRequestURLPath.
+	readonlyURL := *client.BaseURL
+	client.readonlyURL = &readonlyURL
+
 	if client.client == nil {
 		client.client = &http.Client{}
 	}
 
+	client.setDefaultUserAgent()
+
 	userAgentTransport := &userAgentTransport{
 		userAgent: client.UserAgent(),
 		Base:      client.client.Transport,
 	}
 	client.client.Transport = userAgentTransport
 
+	client.wrapCheckRedirect()
+	client.wrapTransport()
+
 	return client, nil
 }
 
+// wrapCheckRedirect makes the client return redirect responses as they are when the
+// request's context asks for it (see noRedirectKey). Otherwise, the client's redirect
+// policy is used, or Go's default one if it doesn't have any.
+func (c *Client) wrapCheckRedirect() {
+	checkRedirect := c.client.CheckRedirect
+	c.client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if noRedirect, _ := req.Context().Value(noRedirectKey{}).(bool); noRedirect {
+			return http.ErrUseLastResponse
+		}
+		if checkRedirect != nil {
+			return checkRedirect(req, via)
+		}
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		return nil
+	}
+}
+
+// wrapTransport wraps the client's transport with the ones configured by options.
+func (c *Client) wrapTransport() {
+	if c.maxConcurrentRequests > 0 {
+		c.client.Transport = newRequestQueueTransport(c.maxConcurrentRequests, c.client.Transport)
+	}
+	if c.cache != nil {
+		c.client.Transport = &cacheTransport{cache: c.cache, Base: c.client.Transport}
+	}
+}
+
+// noRedirectKey is the context key used to make the client return redirect
+// responses as they are, instead of following them.
+type noRedirectKey struct{}
+
 // todo...
 // Some endpoints (notably the ones to get random subreddits/posts) redirect to a
 // reddit.com url, which returns a 403 Forbidden for some reason, unless the url's
@@ -214,12 +332,11 @@ func (c *Client) redirect(req *http.Request, via []*http.Request) error {
 
 // The readonly Reddit url needs .json at the end of its path to return responses in JSON instead of HTML.
 func (c *Client) appendJSONExtensionToRequestURLPath(req *http.Request) {
-	readonlyURL, err := url.Parse(defaultBaseURLReadonly)
-	if err != nil {
+	if c.readonlyURL == nil {
 		return
 	}
 
-	if req.URL.Host != readonlyURL.Host {
+	if req.URL.Scheme != c.readonlyURL.Scheme || req.URL.Host != c.readonlyURL.Host {
 		return
 	}
 
@@ -228,16 +345,23 @@ func (c *Client) appendJSONExtensionToRequestURLPath(req *http.Request) {
 
 // UserAgent returns the client's user agent.
 func (c *Client) UserAgent() string {
-	if c.userAgent == "" {
-		userAgent := fmt.Sprintf("golang:%s:v%s", libraryName, libraryVersion)
-		if c.Username != "" {
-			userAgent += fmt.Sprintf(" (by /u/%s)", c.Username)
-		}
-		c.userAgent = userAgent
-	}
 	return c.userAgent
 }
 
+// setDefaultUserAgent sets the client's user agent if one wasn't provided.
+// It must be called after the options are applied, since the default depends on the username.
+func (c *Client) setDefaultUserAgent() {
+	if c.userAgent != "" {
+		return
+	}
+
+	userAgent := fmt.Sprintf("golang:%s:v%s", libraryName, libraryVersion)
+	if c.Username != "" {
+		userAgent += fmt.Sprintf(" (by /u/%s)", c.Username)
+	}
+	c.userAgent = userAgent
+}
+
 // NewRequest creates an API request with form data as the body.
 // The path is the relative URL which will be resolved to the BaseURL of the Client.
 // It should always be specified without a preceding slash.
@@ -338,15 +462,39 @@ func parseRate(r *http.Response) Rate {
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	if err := c.checkRateLimitBeforeDo(req); err != nil {
-		return &Response{
-			Response: err.Response,
-			Rate:     err.Rate,
-		}, err
+	start := time.Now()
+
+	if c.requestIDGenerator != nil && req.Header.Get(headerRequestID) == "" {
+		req.Header.Set(headerRequestID, c.requestIDGenerator())
 	}
 
+	ctx, span := c.startSpan(ctx, req)
+
+	reserved, rateErr := c.checkRateLimitBeforeDo(req)
+	if rateErr != nil {
+		response := &Response{
+			Response: rateErr.Response,
+			Rate:     rateErr.Rate,
+		}
+		endSpan(span, response, rateErr)
+		// The request never left the client, so it isn't recorded as one made to Reddit.
+		return response, rateErr
+	}
+
+	response, err := c.do(ctx, req, v, reserved)
+	endSpan(span, response, err)
+
+	c.metrics.observe(req, response, time.Since(start))
+	return response, err
+}
+
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}, reserved bool) (*Response, error) {
 	resp, err := DoRequestWithClient(ctx, c.client, req)
 	if err != nil {
+		// Without a response, there's nothing to update the rate limit from.
+		if reserved {
+			c.releaseRateLimitReservation()
+		}
 		return nil, err
 	}
 	defer resp.Body.Close()
@@ -357,9 +505,16 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 
 	response := newResponse(resp)
 
-	c.rateMu.Lock()
-	c.rate = response.Rate
-	c.rateMu.Unlock()
+	if resp.Header.Get(headerFromCache) != "" {
+		// The request never reached Reddit, so it doesn't count towards the rate limit.
+		if reserved {
+			c.releaseRateLimitReservation()
+		}
+	} else {
+		c.rateMu.Lock()
+		c.rate = response.Rate
+		c.rateMu.Unlock()
+	}
 
 	err = CheckResponse(resp)
 	if err != nil {
@@ -373,10 +528,21 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 				return response, err
 			}
 		} else {
-			err = json.NewDecoder(response.Body).Decode(v)
+			// Keep the whole body, so that it can be included in the error if it's invalid.
+			data, err := ioutil.ReadAll(response.Body)
 			if err != nil {
 				return response, err
 			}
+
+			err = json.NewDecoder(bytes.NewReader(data)).Decode(v)
+			if err != nil {
+				return response, &JSONError{
+					Message:    err.Error(),
+					Data:       data,
+					StatusCode: resp.StatusCode,
+					URL:        req.URL.String(),
+				}
+			}
 		}
 
 		if anchor, ok := v.(anchor); ok {
@@ -387,28 +553,44 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return response, nil
 }
 
-func (c *Client) checkRateLimitBeforeDo(req *http.Request) *RateLimitError {
+// checkRateLimitBeforeDo returns an error if the rate limit is known to be exceeded.
+// Otherwise, it reserves one of the remaining requests of the current window, if the
+// window is known, and reports whether it did. Checking and reserving happen under the
+// same lock, so concurrent requests can't all claim the last one.
+func (c *Client) checkRateLimitBeforeDo(req *http.Request) (bool, *RateLimitError) {
 	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+
 	rate := c.rate
-	c.rateMu.Unlock()
+	if rate.Reset.IsZero() || time.Now().After(rate.Reset) {
+		return false, nil
+	}
 
-	if !rate.Reset.IsZero() && rate.Remaining == 0 && time.Now().Before(rate.Reset) {
-		// Create a fake 429 response.
-		resp := &http.Response{
-			Status:     http.StatusText(http.StatusTooManyRequests),
-			StatusCode: http.StatusTooManyRequests,
-			Request:    req,
-			Header:     make(http.Header),
-			Body:       ioutil.NopCloser(strings.NewReader("")),
-		}
-		return &RateLimitError{
-			Rate:     rate,
-			Response: resp,
-			Message:  fmt.Sprintf("API rate limit still exceeded until %s, not making remote request.", rate.Reset),
-		}
+	if rate.Remaining > 0 {
+		c.rate.Remaining--
+		return true, nil
 	}
 
-	return nil
+	// Create a fake 429 response.
+	resp := &http.Response{
+		Status:     http.StatusText(http.StatusTooManyRequests),
+		StatusCode: http.StatusTooManyRequests,
+		Request:    req,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+	}
+	return false, &RateLimitError{
+		Rate:     rate,
+		Response: resp,
+		Message:  fmt.Sprintf("API rate limit still exceeded until %s, not making remote request.", rate.Reset),
+	}
+}
+
+// releaseRateLimitReservation gives back a request reserved by checkRateLimitBeforeDo.
+func (c *Client) releaseRateLimitReservation() {
+	c.rateMu.Lock()
+	c.rate.Remaining++
+	c.rateMu.Unlock()
 }
 
 // id returns the client's Reddit ID.
@@ -470,6 +652,11 @@ func CheckResponse(r *http.Response) error {
 	errorResponse := &ErrorResponse{Response: r}
 	data, err = ioutil.ReadAll(r.Body)
 	if err == nil && len(data) > 0 {
+		errorResponse.BodySnippet = string(data)
+		if len(data) > maxBodySnippetLength {
+			errorResponse.BodySnippet = string(data[:maxBodySnippetLength])
+		}
+
 		err := json.Unmarshal(data, errorResponse)
 		if err != nil {
 			errorResponse.Message = string(data)
@@ -489,6 +676,24 @@ type Rate struct {
 	Reset time.Time `json:"reset"`
 }
 
+// RateLimitWindow is the length of Reddit's rate limit window.
+const RateLimitWindow = 10 * time.Minute
+
+// TimeUntilReset returns the time remaining until the rate limit resets.
+// It is zero if the reset time has already passed.
+func (r Rate) TimeUntilReset() time.Duration {
+	d := time.Until(r.Reset)
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// WindowStart returns the time at which the current rate limit window started.
+func (r Rate) WindowStart() time.Time {
+	return r.Reset.Add(-RateLimitWindow)
+}
+
 // A lot of Reddit's responses return a "thing": { "kind": "...", "data": {...} }
 // So this is just a nice convenient method to have.
 func (c *Client) getThing(ctx context.Context, path string, opts interface{}) (*thing, *Response, error) {
@@ -524,6 +729,7 @@ func (c *Client) getListing(ctx context.Context, path string, opts interface{})
 type ListOptions struct {
 	// Maximum number of items to be returned.
 	// Generally, the default is 25 and max is 100.
+	// If 0, Reddit's default is used.
 	Limit int `url:"limit,omitempty"`
 
 	// The full ID of an item in the listing to use
@@ -537,6 +743,54 @@ type ListOptions struct {
 	Before string `url:"before,omitempty"`
 }
 
+// DefaultListLimit is the number of items Reddit returns in a listing
+// when no limit is specified.
+const DefaultListLimit = 25
+
+// NewListOptions returns ListOptions with the limit explicitly set to Reddit's default.
+func NewListOptions() ListOptions {
+	return ListOptions{Limit: DefaultListLimit}
+}
+
+// WithLimit returns a copy of the options with the limit set to n.
+func (o ListOptions) WithLimit(n int) ListOptions {
+	o.Limit = n
+	return o
+}
+
+// RegionCode is a region used to filter hot posts, e.g. "GLOBAL", "US", or "US_NY".
+type RegionCode string
+
+var regionCodes = []RegionCode{
+	"GLOBAL", "US", "AR", "AU", "BG", "CA", "CL", "CO", "HR", "CZ", "FI", "FR",
+	"DE", "GR", "HU", "IS", "IN", "IE", "IT", "JP", "MY", "MX", "NZ", "PH", "PL",
+	"PT", "PR", "RO", "RS", "SG", "ES", "SE", "TW", "TH", "TR", "GB", "US_WA",
+	"US_DE", "US_DC", "US_WI", "US_WV", "US_HI", "US_FL", "US_WY", "US_NH",
+	"US_NJ", "US_NM", "US_TX", "US_LA", "US_NC", "US_ND", "US_NE", "US_TN",
+	"US_NY", "US_PA", "US_CA", "US_NV", "US_VA", "US_CO", "US_AK", "US_AL",
+	"US_AR", "US_VT", "US_IL", "US_GA", "US_IN", "US_IA", "US_OK", "US_AZ",
+	"US_ID", "US_CT", "US_ME", "US_MD", "US_MA", "US_OH", "US_UT", "US_MO",
+	"US_MN", "US_MI", "US_RI", "US_KS", "US_MT", "US_MS", "US_SC", "US_KY",
+	"US_OR", "US_SD",
+}
+
+// AllRegionCodes returns all region codes supported by Reddit.
+func AllRegionCodes() []RegionCode {
+	codes := make([]RegionCode, len(regionCodes))
+	copy(codes, regionCodes)
+	return codes
+}
+
+// Valid reports whether the region code is supported by Reddit.
+func (c RegionCode) Valid() bool {
+	for _, code := range regionCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
 // ListSubredditOptions defines possible options used when searching for subreddits.
 type ListSubredditOptions struct {
 	ListOptions
@@ -580,6 +834,29 @@ type ListDuplicatePostOptions struct {
 	CrosspostsOnly bool `url:"crossposts_only,omitempty"`
 }
 
+// ListPostCommentsOptions defines possible options used when getting a post and its comments.
+type ListPostCommentsOptions struct {
+	// Maximum number of comments to be returned.
+	// If 0, Reddit's default is used.
+	Limit int `url:"limit,omitempty"`
+	// Maximum depth of the comment tree. Top-level comments have a depth of 0.
+	// If 0, Reddit's default is used.
+	Depth int `url:"depth,omitempty"`
+	// One of: confidence (i.e. best), top, new, controversial, old, random, qa, live.
+	Sort string `url:"sort,omitempty"`
+	// The ID36 of a comment. If provided, only that comment and its replies are returned.
+	Comment string `url:"comment,omitempty"`
+	// The number of parents of Comment to include, between 0 and 8.
+	Context int `url:"context,omitempty"`
+}
+
+func (o *ListPostCommentsOptions) validate() error {
+	if o.Context < 0 || o.Context > 8 {
+		return fmt.Errorf("(*ListPostCommentsOptions).Context: %d is not between 0 and 8", o.Context)
+	}
+	return nil
+}
+
 // ListModActionOptions defines possible options used when getting moderation actions in a subreddit.
 type ListModActionOptions struct {
 	// The max for the limit parameter here is 500.
@@ -600,6 +877,76 @@ type ListModActionOptions struct {
 	Moderator string `url:"mod,omitempty"`
 }
 
+// modActionTypes are the valid values of ListModActionOptions.Type.
+var modActionTypes = []string{
+	"banuser", "unbanuser", "spamlink", "removelink", "approvelink", "spamcomment",
+	"removecomment", "approvecomment", "addmoderator", "showcomment", "invitemoderator",
+	"uninvitemoderator", "acceptmoderatorinvite", "removemoderator", "addcontributor",
+	"removecontributor", "editsettings", "editflair", "distinguish", "marknsfw",
+	"wikibanned", "wikicontributor", "wikiunbanned", "wikipagelisted",
+	"removewikicontributor", "wikirevise", "wikipermlevel", "ignorereports",
+	"unignorereports", "setpermissions", "setsuggestedsort", "sticky", "unsticky",
+	"setcontestmode", "unsetcontestmode", "lock", "unlock", "muteuser", "unmuteuser",
+	"createrule", "editrule", "reorderrules", "deleterule", "spoiler", "unspoiler",
+	"modmail_enrollment", "community_styling", "community_widgets", "markoriginalcontent",
+	"collections", "events", "hidden_award", "add_community_topics",
+	"remove_community_topics", "create_scheduled_post", "edit_scheduled_post",
+	"delete_scheduled_post", "submit_scheduled_post", "edit_post_requirements",
+	"invitesubscriber", "submit_content_rating_survey",
+}
+
+func (o *ListModActionOptions) validate() error {
+	if o.Limit > 500 {
+		return fmt.Errorf("(*ListModActionOptions).Limit: %d is greater than the max of 500", o.Limit)
+	}
+	if o.Type == "" {
+		return nil
+	}
+	for _, t := range modActionTypes {
+		if o.Type == t {
+			return nil
+		}
+	}
+	return fmt.Errorf("(*ListModActionOptions).Type: %q is not a valid action type", o.Type)
+}
+
+// ListModNotesOptions defines possible options used when getting a user's mod notes in a subreddit.
+type ListModNotesOptions struct {
+	// Maximum number of notes to be returned. The max is 100.
+	// If 0, Reddit's default is used.
+	Limit int `url:"limit,omitempty"`
+	// The cursor to use as the anchor point of the list. Only notes older than
+	// the one it belongs to will be returned. Notes are returned newest first.
+	Before string `url:"before,omitempty"`
+	// If empty, notes of all types are returned.
+	Filter ModNoteType `url:"filter,omitempty"`
+}
+
+func (o *ListModNotesOptions) validate() error {
+	if o.Limit > 100 {
+		return fmt.Errorf("(*ListModNotesOptions).Limit: %d is greater than the max of 100", o.Limit)
+	}
+	if o.Filter != "" && !o.Filter.Valid() {
+		return fmt.Errorf("(*ListModNotesOptions).Filter: %q is not a valid mod note type", o.Filter)
+	}
+	return nil
+}
+
+// ListModmailConversationsOptions defines possible options used when getting modmail conversations.
+type ListModmailConversationsOptions struct {
+	// Maximum number of conversations to be returned. The max is 100.
+	Limit int `url:"limit,omitempty"`
+	// The ID of a conversation to use as the anchor point of the list.
+	After string `url:"after,omitempty"`
+	// Comma-separated names of the subreddits to get conversations from.
+	// If empty, conversations from all the subreddits you moderate are returned.
+	Subreddits string `url:"entity,omitempty"`
+	// One of: recent, mod, user, unread.
+	Sort string `url:"sort,omitempty"`
+	// One of: all, new, inprogress, archived, appeals, join_requests, highlighted, mod, notifications.
+	State string `url:"state,omitempty"`
+}
+
 func addOptions(s string, opt interface{}) (string, error) {
 	v := reflect.ValueOf(opt)
 	if v.Kind() == reflect.Ptr && v.IsNil() {
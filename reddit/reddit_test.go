@@ -7,12 +7,17 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
 )
 
 var ctx = context.Background()
@@ -61,6 +66,7 @@ func readFileContents(path string) (string, error) {
 func testClientServices(t *testing.T, c *Client) {
 	services := []string{
 		"Account",
+		"Chat",
 		"Collection",
 		"Comment",
 		"Emoji",
@@ -72,6 +78,8 @@ func testClientServices(t *testing.T, c *Client) {
 		"Moderation",
 		"Multi",
 		"Post",
+		"Prediction",
+		"ScheduledPost",
 		"Stream",
 		"Subreddit",
 		"User",
@@ -133,10 +141,75 @@ func TestNewReadonlyClient_Error(t *testing.T) {
 	require.EqualError(t, err, "foo")
 }
 
+func TestNewReadonlyClient_OAuthOpts(t *testing.T) {
+	defer func() {
+		os.Unsetenv("GO_REDDIT_CLIENT_ID")
+	}()
+	os.Setenv("GO_REDDIT_CLIENT_ID", "id1")
+
+	_, err := NewReadonlyClient(FromEnv)
+	require.EqualError(t, err, "read-only client cannot be configured with credentials")
+
+	_, err = NewReadonlyClient(WithOnTokenRefresh(func(*oauth2.Token) {}))
+	require.EqualError(t, err, "read-only client cannot be configured with a token refresh callback")
+}
+
+func TestNewReadonlyClient_NoAuthorization(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("read-only client should not request an access token")
+	})
+
+	var count int
+	mux.HandleFunc("/api/v1/test.json", func(w http.ResponseWriter, r *http.Request) {
+		count++
+		require.Empty(t, r.Header.Get("Authorization"))
+	})
+
+	c, err := NewReadonlyClient(WithBaseURL(server.URL), WithTokenURL(server.URL+"/api/v1/access_token"))
+	require.NoError(t, err)
+
+	req, err := c.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	_, err = c.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
+
 func TestDefaultClient(t *testing.T) {
 	require.NotNil(t, DefaultClient())
 }
 
+func TestDefaultClient_Concurrent(t *testing.T) {
+	ResetDefaultClient()
+
+	const n = 20
+	clients := make(chan *Client, n)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			clients <- DefaultClient()
+		}()
+	}
+	wg.Wait()
+	close(clients)
+
+	first := DefaultClient()
+	for c := range clients {
+		require.Same(t, first, c)
+	}
+
+	ResetDefaultClient()
+	require.NotSame(t, first, DefaultClient())
+}
+
 func TestClient_Readonly_NewRequest(t *testing.T) {
 	c, err := NewReadonlyClient()
 	require.NoError(t, err)
@@ -146,6 +219,43 @@ func TestClient_Readonly_NewRequest(t *testing.T) {
 	require.Equal(t, defaultBaseURLReadonly+"/r/golang.json", req.URL.String())
 }
 
+func TestClient_Readonly_NewRequest_BaseURL(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/r/golang.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+	})
+
+	c, err := NewReadonlyClient(WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	req, err := c.NewRequest(http.MethodGet, "r/golang", nil)
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/r/golang.json", req.URL.String())
+
+	_, err = c.Do(ctx, req, nil)
+	require.NoError(t, err)
+
+	req, err = c.NewJSONRequest(http.MethodGet, "r/golang", nil)
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/r/golang.json", req.URL.String())
+
+	// the same host with a different scheme is a different URL
+	req, err = c.NewRequest(http.MethodGet, strings.Replace(server.URL, "http://", "https://", 1)+"/r/golang", nil)
+	require.NoError(t, err)
+	require.False(t, strings.HasSuffix(req.URL.Path, ".json"))
+}
+
+func TestClient_NewRequest_NoJSONExtension(t *testing.T) {
+	client, _ := setup(t)
+
+	req, err := client.NewRequest(http.MethodGet, "r/golang", nil)
+	require.NoError(t, err)
+	require.Equal(t, client.BaseURL.String()+"/r/golang", req.URL.String())
+}
+
 func TestClient_OnRequestComplemented(t *testing.T) {
 	client, mux := setup(t)
 
@@ -222,6 +332,72 @@ func TestClient_ErrorResponse(t *testing.T) {
 	require.Equal(t, http.StatusForbidden, resp.StatusCode)
 }
 
+func TestClient_ErrorResponse_BodySnippet(t *testing.T) {
+	client, mux := setup(t)
+
+	page := "<html><head><title>502 Bad Gateway</title></head><body>" + strings.Repeat("a", 1000) + "</body></html>"
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		w.Header().Set(headerContentType, "text/html")
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, page)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.IsType(t, &ErrorResponse{}, err)
+
+	errorResponse := err.(*ErrorResponse)
+	require.True(t, strings.HasPrefix(errorResponse.BodySnippet, "<"))
+	require.Equal(t, page[:512], errorResponse.BodySnippet)
+	require.Equal(t, page, errorResponse.Message)
+}
+
+func TestClient_JSONError(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{"malformed": `)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	var v map[string]interface{}
+	resp, err := client.Do(ctx, req, &v)
+	require.IsType(t, &JSONError{}, err)
+
+	jsonErr := err.(*JSONError)
+	require.Equal(t, http.StatusOK, jsonErr.StatusCode)
+	require.Equal(t, client.BaseURL.String()+"/api/v1/test", jsonErr.URL)
+	require.Equal(t, "unexpected EOF", jsonErr.Message)
+	require.Equal(t, []byte(`{"malformed": `), jsonErr.Data)
+	require.EqualError(t, err, fmt.Sprintf("invalid JSON from %s/api/v1/test: 200 unexpected EOF", client.BaseURL))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestClient_JSONError_Data(t *testing.T) {
+	client, mux := setup(t)
+
+	// the decoder stops reading at the first invalid character, the rest must still be kept
+	page := "<html><body>" + strings.Repeat("a", 10000) + "</body></html>"
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, page)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	var v map[string]interface{}
+	_, err = client.Do(ctx, req, &v)
+	require.IsType(t, &JSONError{}, err)
+	require.Equal(t, []byte(page), err.(*JSONError).Data)
+}
+
 func TestClient_Do_RateLimitError(t *testing.T) {
 	client, mux := setup(t)
 
@@ -271,3 +447,241 @@ func TestClient_Do_RateLimitError(t *testing.T) {
 	require.Equal(t, 600, resp.Rate.Used)
 	require.Equal(t, time.Now().Truncate(time.Second).Add(time.Minute*4), resp.Rate.Reset)
 }
+
+func TestClient_Do_RateLimitReleasedOnError(t *testing.T) {
+	client, _ := setup(t)
+
+	server := httptest.NewServer(nil)
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	server.Close()
+	client.BaseURL = baseURL
+
+	client.rate.Remaining = 5
+	client.rate.Reset = time.Now().Add(time.Minute)
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+	require.Error(t, err)
+	require.Nil(t, resp)
+
+	// the request never reached Reddit, so the reserved request is given back
+	require.Equal(t, 5, client.rate.Remaining)
+}
+
+func TestClient_Do_RateLimitConcurrent(t *testing.T) {
+	client, mux := setup(t)
+
+	release := make(chan struct{})
+	var counter int32
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&counter, 1)
+		<-release
+	})
+
+	client.rate = Rate{Remaining: 3, Reset: time.Now().Add(time.Minute)}
+
+	const n = 10
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		go func() {
+			req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+			if err != nil {
+				errs <- err
+				return
+			}
+			_, err = client.Do(ctx, req, nil)
+			errs <- err
+		}()
+	}
+
+	// The requests that got through are blocked by the server, so the first
+	// results can only come from the ones that were rejected before being sent.
+	for i := 0; i < n-3; i++ {
+		require.IsType(t, &RateLimitError{}, <-errs)
+	}
+	close(release)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, <-errs)
+	}
+
+	require.Equal(t, int32(3), atomic.LoadInt32(&counter))
+}
+
+func TestClient_UserAgent_Concurrent(t *testing.T) {
+	c, err := NewClient(Credentials{Username: "user1"})
+	require.NoError(t, err)
+
+	expectedUserAgent := fmt.Sprintf("golang:%s:v%s (by /u/user1)", libraryName, libraryVersion)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.Equal(t, expectedUserAgent, c.UserAgent())
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRate_TimeUntilReset(t *testing.T) {
+	rate := Rate{Reset: time.Now().Add(time.Minute)}
+	require.True(t, rate.TimeUntilReset() > 0)
+
+	rate = Rate{Reset: time.Now().Add(-time.Minute)}
+	require.Equal(t, time.Duration(0), rate.TimeUntilReset())
+}
+
+func TestRate_WindowStart(t *testing.T) {
+	reset := time.Date(2020, 1, 1, 12, 10, 0, 0, time.UTC)
+	rate := Rate{Reset: reset}
+	require.Equal(t, time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC), rate.WindowStart())
+}
+
+func TestListOptions_Defaults(t *testing.T) {
+	opts := NewListOptions()
+	require.Equal(t, ListOptions{Limit: 25}, opts)
+
+	withLimit := opts.WithLimit(100)
+	require.Equal(t, ListOptions{Limit: 100}, withLimit)
+	require.Equal(t, 25, opts.Limit)
+}
+
+func TestRegionCode_Valid(t *testing.T) {
+	codes := AllRegionCodes()
+	require.Len(t, codes, 87)
+	for _, code := range codes {
+		require.True(t, code.Valid(), code)
+	}
+
+	require.False(t, RegionCode("ZZ").Valid())
+	require.False(t, RegionCode("").Valid())
+	require.False(t, RegionCode("us").Valid())
+}
+
+// contextTest calls fn with a context that's already cancelled,
+// and checks that it fails because of the cancellation.
+func contextTest(t *testing.T, fn func(ctx context.Context) error) {
+	t.Helper()
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	err := fn(cancelledCtx)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, context.Canceled), "expected context.Canceled, got: %v", err)
+}
+
+func TestClient_CancelledContext(t *testing.T) {
+	client, mux := setup(t)
+
+	var requests int32
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	})
+
+	tests := map[string]func(ctx context.Context) error{
+		"Account.Info": func(ctx context.Context) error {
+			_, _, err := client.Account.Info(ctx)
+			return err
+		},
+		"Account.Settings": func(ctx context.Context) error {
+			_, _, err := client.Account.Settings(ctx)
+			return err
+		},
+		"Chat.Rooms": func(ctx context.Context) error {
+			_, _, err := client.Chat.Rooms(ctx, "testsubreddit")
+			return err
+		},
+		"Collection.Get": func(ctx context.Context) error {
+			_, _, err := client.Collection.Get(ctx, "37f1e52d-7ec9-466b-b4cc-59e86e071ed7")
+			return err
+		},
+		"Comment.Lock": func(ctx context.Context) error {
+			_, err := client.Comment.Lock(ctx, "t1_test")
+			return err
+		},
+		"Emoji.Get": func(ctx context.Context) error {
+			_, _, _, err := client.Emoji.Get(ctx, "testsubreddit")
+			return err
+		},
+		"Flair.GetUserFlairs": func(ctx context.Context) error {
+			_, _, err := client.Flair.GetUserFlairs(ctx, "testsubreddit")
+			return err
+		},
+		"Gold.Gild": func(ctx context.Context) error {
+			_, err := client.Gold.Gild(ctx, "t3_test")
+			return err
+		},
+		"Listings.Get": func(ctx context.Context) error {
+			_, _, _, _, err := client.Listings.Get(ctx, "t3_test")
+			return err
+		},
+		"LiveThread.Now": func(ctx context.Context) error {
+			_, _, err := client.LiveThread.Now(ctx)
+			return err
+		},
+		"Message.ReadAll": func(ctx context.Context) error {
+			_, err := client.Message.ReadAll(ctx)
+			return err
+		},
+		"Moderation.Actions": func(ctx context.Context) error {
+			_, _, err := client.Moderation.Actions(ctx, "testsubreddit", nil)
+			return err
+		},
+		"Moderation.Queue": func(ctx context.Context) error {
+			_, _, _, err := client.Moderation.Queue(ctx, "testsubreddit", nil)
+			return err
+		},
+		"Multi.Get": func(ctx context.Context) error {
+			_, _, err := client.Multi.Get(ctx, "user/testuser/m/testmulti")
+			return err
+		},
+		"Post.Get": func(ctx context.Context) error {
+			_, _, err := client.Post.Get(ctx, "test")
+			return err
+		},
+		"Prediction.Leaderboard": func(ctx context.Context) error {
+			_, _, err := client.Prediction.Leaderboard(ctx, "testsubreddit")
+			return err
+		},
+		"ScheduledPost.Create": func(ctx context.Context) error {
+			_, _, err := client.ScheduledPost.Create(ctx, "testsubreddit", &ScheduledPostRequest{
+				Title:     "test",
+				ExecuteAt: time.Now().Add(time.Hour),
+			})
+			return err
+		},
+		"Subreddit.Get": func(ctx context.Context) error {
+			_, _, err := client.Subreddit.Get(ctx, "testsubreddit")
+			return err
+		},
+		"Subreddit.AllSubscribed": func(ctx context.Context) error {
+			_, _, err := client.Subreddit.AllSubscribed(ctx, 0)
+			return err
+		},
+		"User.Get": func(ctx context.Context) error {
+			_, _, err := client.User.Get(ctx, "testuser")
+			return err
+		},
+		"Widget.Get": func(ctx context.Context) error {
+			_, _, err := client.Widget.Get(ctx, "testsubreddit")
+			return err
+		},
+		"Wiki.Page": func(ctx context.Context) error {
+			_, _, err := client.Wiki.Page(ctx, "testsubreddit", "index")
+			return err
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			contextTest(t, fn)
+		})
+	}
+
+	require.Zero(t, atomic.LoadInt32(&requests))
+}
@@ -0,0 +1,92 @@
+package reddit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// ScheduledPostService handles communication with the scheduled post
+// related methods of the Reddit API.
+// Scheduling posts requires the "posts" moderator permission in the subreddit.
+type ScheduledPostService struct {
+	client *Client
+}
+
+// ScheduledPostRequest represents a request to schedule a post.
+// If URL is set, a link post is scheduled. Otherwise, a text post is.
+type ScheduledPostRequest struct {
+	Title string `url:"title"`
+	Text  string `url:"text,omitempty"`
+	URL   string `url:"url,omitempty"`
+
+	FlairID   string `url:"flair_id,omitempty"`
+	FlairText string `url:"flair_text,omitempty"`
+
+	NSFW    bool `url:"nsfw,omitempty"`
+	Spoiler bool `url:"spoiler,omitempty"`
+
+	// The time the post will be submitted at. Must be in the future.
+	ExecuteAt time.Time `url:"-"`
+}
+
+func (r *ScheduledPostRequest) validate() error {
+	if r.Title == "" {
+		return errors.New("(*ScheduledPostRequest).Title: cannot be empty")
+	}
+	if r.Text != "" && r.URL != "" {
+		return errors.New("*ScheduledPostRequest: only one of Text or URL may be set")
+	}
+	if !r.ExecuteAt.After(time.Now()) {
+		return errors.New("(*ScheduledPostRequest).ExecuteAt: must be in the future")
+	}
+	return nil
+}
+
+// Create schedules a post in the subreddit and returns the id of the scheduled post.
+func (s *ScheduledPostService) Create(ctx context.Context, subreddit string, request *ScheduledPostRequest) (string, *Response, error) {
+	if request == nil {
+		return "", nil, errors.New("*ScheduledPostRequest: cannot be nil")
+	}
+	if err := request.validate(); err != nil {
+		return "", nil, err
+	}
+
+	form, err := query.Values(request)
+	if err != nil {
+		return "", nil, err
+	}
+
+	kind := "self"
+	if request.URL != "" {
+		kind = "link"
+	}
+	form.Set("kind", kind)
+	form.Set("publish_at", strconv.FormatInt(request.ExecuteAt.Unix(), 10))
+	form.Set("api_type", "json")
+
+	path := fmt.Sprintf("r/%s/api/submit_scheduled_post_time", subreddit)
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return "", nil, err
+	}
+
+	root := new(struct {
+		JSON struct {
+			Data struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		} `json:"json"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return root.JSON.Data.ID, resp, nil
+}
@@ -0,0 +1,75 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduledPostService_Create(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/submit_scheduled_post_time", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("kind", "link")
+		form.Set("title", "Release notes")
+		form.Set("url", "https://example.com/release-notes")
+		form.Set("spoiler", "true")
+		form.Set("publish_at", "1893542400")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, `{
+			"json": {
+				"data": {
+					"id": "ScheduledPost_9d1e7c3a-2f5b-4e8a-b6c0-7a4d3e2f1b90"
+				},
+				"errors": []
+			}
+		}`)
+	})
+
+	_, _, err := client.ScheduledPost.Create(ctx, "testsubreddit", nil)
+	require.EqualError(t, err, "*ScheduledPostRequest: cannot be nil")
+
+	id, _, err := client.ScheduledPost.Create(ctx, "testsubreddit", &ScheduledPostRequest{
+		Title:     "Release notes",
+		URL:       "https://example.com/release-notes",
+		Spoiler:   true,
+		ExecuteAt: time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ScheduledPost_9d1e7c3a-2f5b-4e8a-b6c0-7a4d3e2f1b90", id)
+}
+
+func TestScheduledPostService_Create_Invalid(t *testing.T) {
+	client, _ := setup(t)
+
+	executeAt := time.Now().Add(time.Hour)
+
+	_, _, err := client.ScheduledPost.Create(ctx, "testsubreddit", &ScheduledPostRequest{ExecuteAt: executeAt})
+	require.EqualError(t, err, "(*ScheduledPostRequest).Title: cannot be empty")
+
+	_, _, err = client.ScheduledPost.Create(ctx, "testsubreddit", &ScheduledPostRequest{
+		Title:     "test",
+		Text:      "test",
+		URL:       "https://example.com",
+		ExecuteAt: executeAt,
+	})
+	require.EqualError(t, err, "*ScheduledPostRequest: only one of Text or URL may be set")
+
+	_, _, err = client.ScheduledPost.Create(ctx, "testsubreddit", &ScheduledPostRequest{
+		Title:     "test",
+		ExecuteAt: time.Now().Add(-time.Hour),
+	})
+	require.EqualError(t, err, "(*ScheduledPostRequest).ExecuteAt: must be in the future")
+}
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 
 	"github.com/google/go-querystring/query"
@@ -118,6 +119,28 @@ type SubredditTrafficStats struct {
 	Subscribers int `json:"subscribers"`
 }
 
+// SubredditAboutV2 holds the information about a subreddit returned by Reddit's newer about endpoint.
+// On top of the basics, it includes what can be posted in the subreddit, its rules, and its widgets.
+type SubredditAboutV2 struct {
+	ID          string     `json:"id,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Title       string     `json:"title,omitempty"`
+	Description string     `json:"publicDescription,omitempty"`
+	Created     *Timestamp `json:"createdUtc,omitempty"`
+	Subscribers int        `json:"subscribers"`
+
+	// The types of posts allowed in the subreddit, e.g. text, link, image, video, poll.
+	AllowedPostTypes []string `json:"allowedPostTypes,omitempty"`
+	// The kinds of flair enabled in the subreddit: user, post, or both.
+	FlairTypes []string `json:"flairTypes,omitempty"`
+
+	Rules   []*SubredditRule `json:"communityRules,omitempty"`
+	Widgets WidgetList       `json:"widgets,omitempty"`
+
+	ContentCategory string   `json:"contentCategory,omitempty"`
+	Topics          []string `json:"topics,omitempty"`
+}
+
 // SubredditImage is an image part of the image set of a subreddit.
 type SubredditImage struct {
 	Name string `json:"name"`
@@ -324,6 +347,24 @@ func (s *SubredditService) HotPosts(ctx context.Context, subreddit string, opts
 	return s.getPosts(ctx, "hot", subreddit, opts)
 }
 
+// HotPostsInRegion returns the hottest posts from the specified subreddit, as seen from the region.
+// It otherwise behaves like HotPosts.
+func (s *SubredditService) HotPostsInRegion(ctx context.Context, subreddit string, region RegionCode, opts *ListOptions) ([]*Post, *Response, error) {
+	if !region.Valid() {
+		return nil, nil, fmt.Errorf("region: %q is not a valid region code", region)
+	}
+
+	params := struct {
+		ListOptions
+		Region RegionCode `url:"g"`
+	}{Region: region}
+	if opts != nil {
+		params.ListOptions = *opts
+	}
+
+	return s.getPosts(ctx, "hot", subreddit, params)
+}
+
 // NewPosts returns the newest posts from the specified subreddit.
 // To search through multiple, separate the names with a plus (+), e.g. "golang+test".
 // If none are defined, it returns the ones from your subscribed subreddits.
@@ -376,6 +417,37 @@ func (s *SubredditService) Get(ctx context.Context, name string) (*Subreddit, *R
 	return sr, resp, nil
 }
 
+// SubscriberCount returns the number of users subscribed to the subreddit.
+func (s *SubredditService) SubscriberCount(ctx context.Context, name string) (int, *Response, error) {
+	sr, resp, err := s.Get(ctx, name)
+	if err != nil {
+		return 0, resp, err
+	}
+	return sr.Subscribers, resp, nil
+}
+
+// GetAboutV2 gets a subreddit by name from Reddit's newer about endpoint, which returns
+// more information than Get, such as the subreddit's rules and widgets.
+func (s *SubredditService) GetAboutV2(ctx context.Context, name string) (*SubredditAboutV2, *Response, error) {
+	if name == "" {
+		return nil, nil, errors.New("name: cannot be empty")
+	}
+
+	path := fmt.Sprintf("api/v1/%s/about", name)
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	about := new(SubredditAboutV2)
+	resp, err := s.client.Do(ctx, req, about)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return about, resp, nil
+}
+
 // Popular returns popular subreddits.
 func (s *SubredditService) Popular(ctx context.Context, opts *ListSubredditOptions) ([]*Subreddit, *Response, error) {
 	return s.getSubreddits(ctx, "subreddits/popular", opts)
@@ -402,6 +474,13 @@ func (s *SubredditService) Subscribed(ctx context.Context, opts *ListSubredditOp
 	return s.getSubreddits(ctx, "subreddits/mine/subscriber", opts)
 }
 
+// AllSubscribed returns the subreddits you are subscribed to, going through every
+// page of the listing until limit subreddits are returned. If limit is 0 or less, all of them are.
+// The returned response is the one of the last page.
+func (s *SubredditService) AllSubscribed(ctx context.Context, limit int) ([]*Subreddit, *Response, error) {
+	return s.getAllSubreddits(ctx, "subreddits/mine/subscriber", limit)
+}
+
 // Approved returns the list of subreddits you are an approved user in.
 func (s *SubredditService) Approved(ctx context.Context, opts *ListSubredditOptions) ([]*Subreddit, *Response, error) {
 	return s.getSubreddits(ctx, "subreddits/mine/contributor", opts)
@@ -412,6 +491,13 @@ func (s *SubredditService) Moderated(ctx context.Context, opts *ListSubredditOpt
 	return s.getSubreddits(ctx, "subreddits/mine/moderator", opts)
 }
 
+// AllModerated returns the subreddits you are a moderator of, going through every
+// page of the listing until limit subreddits are returned. If limit is 0 or less, all of them are.
+// The returned response is the one of the last page.
+func (s *SubredditService) AllModerated(ctx context.Context, limit int) ([]*Subreddit, *Response, error) {
+	return s.getAllSubreddits(ctx, "subreddits/mine/moderator", limit)
+}
+
 // GetSticky1 returns the first stickied post on a subreddit (if it exists).
 func (s *SubredditService) GetSticky1(ctx context.Context, subreddit string) (*PostAndComments, *Response, error) {
 	return s.getSticky(ctx, subreddit, 1)
@@ -499,7 +585,13 @@ func (s *SubredditService) Unfavorite(ctx context.Context, subreddit string) (*R
 
 // Search for subreddits.
 func (s *SubredditService) Search(ctx context.Context, query string, opts *ListSubredditOptions) ([]*Subreddit, *Response, error) {
-	path := fmt.Sprintf("subreddits/search?q=%s", query)
+	path, err := addOptions("subreddits/search", struct {
+		Query string `url:"q"`
+	}{query})
+	if err != nil {
+		return nil, nil, err
+	}
+
 	l, resp, err := s.client.getListing(ctx, path, opts)
 	if err != nil {
 		return nil, resp, err
@@ -509,7 +601,12 @@ func (s *SubredditService) Search(ctx context.Context, query string, opts *ListS
 
 // SearchNames searches for subreddits with names beginning with the query provided.
 func (s *SubredditService) SearchNames(ctx context.Context, query string) ([]string, *Response, error) {
-	path := fmt.Sprintf("api/search_reddit_names?query=%s", query)
+	path, err := addOptions("api/search_reddit_names", struct {
+		Query string `url:"query"`
+	}{query})
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
 	if err != nil {
@@ -525,10 +622,46 @@ func (s *SubredditService) SearchNames(ctx context.Context, query string) ([]str
 	return root.Names, resp, nil
 }
 
+// SearchFacet is the number of search results coming from a subreddit or user profile.
+type SearchFacet struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// SearchFacets breaks down search results by where they come from.
+type SearchFacets struct {
+	Subreddits []*SearchFacet `json:"subreddits,omitempty"`
+	Profiles   []*SearchFacet `json:"profiles,omitempty"`
+}
+
 // SearchPosts searches for posts in the specified subreddit.
 // To search through multiple, separate the names with a plus (+), e.g. "golang+test".
 // If no subreddit is provided, the search is run against r/all.
 func (s *SubredditService) SearchPosts(ctx context.Context, query string, subreddit string, opts *ListPostSearchOptions) ([]*Post, *Response, error) {
+	l, resp, err := s.searchPosts(ctx, query, subreddit, false, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Posts(), resp, nil
+}
+
+// SearchPostsWithFacets is like SearchPosts, but also returns how many of the
+// results come from each subreddit and user profile.
+func (s *SubredditService) SearchPostsWithFacets(ctx context.Context, query string, subreddit string, opts *ListPostSearchOptions) ([]*Post, *SearchFacets, *Response, error) {
+	l, resp, err := s.searchPosts(ctx, query, subreddit, true, opts)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	facets := l.facets
+	if facets == nil {
+		facets = new(SearchFacets)
+	}
+
+	return l.Posts(), facets, resp, nil
+}
+
+func (s *SubredditService) searchPosts(ctx context.Context, query string, subreddit string, includeFacets bool, opts *ListPostSearchOptions) (*listing, *Response, error) {
 	if subreddit == "" {
 		subreddit = "all"
 	}
@@ -544,15 +677,10 @@ func (s *SubredditService) SearchPosts(ctx context.Context, query string, subred
 	params := struct {
 		Query              string `url:"q"`
 		RestrictSubreddits bool   `url:"restrict_sr,omitempty"`
-	}{query, notAll}
-
-	t, resp, err := s.client.getThing(ctx, path, params)
-	if err != nil {
-		return nil, resp, err
-	}
+		IncludeFacets      bool   `url:"include_facets,omitempty"`
+	}{query, notAll, includeFacets}
 
-	l, _ := t.Listing()
-	return l.Posts(), resp, nil
+	return s.client.getListing(ctx, path, params)
 }
 
 func (s *SubredditService) getSubreddits(ctx context.Context, path string, opts *ListSubredditOptions) ([]*Subreddit, *Response, error) {
@@ -563,6 +691,31 @@ func (s *SubredditService) getSubreddits(ctx context.Context, path string, opts
 	return l.Subreddits(), resp, nil
 }
 
+// The max number of subreddits per page of a listing.
+const maxSubredditsPerPage = 100
+
+func (s *SubredditService) getAllSubreddits(ctx context.Context, path string, limit int) ([]*Subreddit, *Response, error) {
+	var subreddits []*Subreddit
+	listOpts := &ListSubredditOptions{ListOptions: ListOptions{Limit: maxSubredditsPerPage}}
+
+	for {
+		page, resp, err := s.getSubreddits(ctx, path, listOpts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		subreddits = append(subreddits, page...)
+		if limit > 0 && len(subreddits) >= limit {
+			return subreddits[:limit], resp, nil
+		}
+
+		if resp.After == "" || len(page) == 0 {
+			return subreddits, resp, nil
+		}
+		listOpts.After = resp.After
+	}
+}
+
 // getSticky returns one of the 2 stickied posts of the subreddit (if they exist).
 // Num should be equal to 1 or 2, depending on which one you want.
 func (s *SubredditService) getSticky(ctx context.Context, subreddit string, num int) (*PostAndComments, *Response, error) {
@@ -722,7 +875,7 @@ func (s *SubredditService) Muted(ctx context.Context, subreddit string, opts *Li
 	return root.Data.Relationships, resp, nil
 }
 
-// WikiBanned gets banned users from the subreddit.
+// WikiBanned gets users banned from contributing to the subreddit's wiki.
 func (s *SubredditService) WikiBanned(ctx context.Context, subreddit string, opts *ListOptions) ([]*Ban, *Response, error) {
 	path := fmt.Sprintf("r/%s/about/wikibanned", subreddit)
 
@@ -925,14 +1078,52 @@ func (s *SubredditService) StyleSheetRaw(ctx context.Context, subreddit string)
 	return buf.String(), resp, nil
 }
 
+// StyleSheetURL returns the URL of the subreddit's style sheet file.
+// Getting the style sheet redirects to this file, so the URL is read from the redirect's
+// Location header. The redirect isn't followed.
+func (s *SubredditService) StyleSheetURL(ctx context.Context, subreddit string) (string, *Response, error) {
+	path := fmt.Sprintf("r/%s/stylesheet", subreddit)
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	ctx = context.WithValue(ctx, noRedirectKey{}, true)
+	resp, err := s.client.Do(ctx, req, nil)
+	if resp == nil || resp.StatusCode < 300 || resp.StatusCode > 399 {
+		if err != nil {
+			return "", resp, err
+		}
+		return "", resp, fmt.Errorf("expected a redirect to the style sheet, got status %d", resp.StatusCode)
+	}
+
+	location, err := resp.Location()
+	if err != nil {
+		return "", resp, err
+	}
+
+	return location.String(), resp, nil
+}
+
 // UpdateStyleSheet updates the style sheet of the subreddit.
 // Providing a reason is optional.
 func (s *SubredditService) UpdateStyleSheet(ctx context.Context, subreddit, styleSheet, reason string) (*Response, error) {
+	return s.styleSheet(ctx, subreddit, "save", styleSheet, reason)
+}
+
+// PreviewStyleSheet checks the style sheet for errors without saving it.
+// Invalid CSS is returned as a *JSONErrorResponse, just like it would be by UpdateStyleSheet.
+func (s *SubredditService) PreviewStyleSheet(ctx context.Context, subreddit, styleSheet string) (*Response, error) {
+	return s.styleSheet(ctx, subreddit, "preview", styleSheet, "")
+}
+
+func (s *SubredditService) styleSheet(ctx context.Context, subreddit, op, styleSheet, reason string) (*Response, error) {
 	path := fmt.Sprintf("r/%s/api/subreddit_stylesheet", subreddit)
 
 	form := url.Values{}
 	form.Set("api_type", "json")
-	form.Set("op", "save")
+	form.Set("op", op)
 	form.Set("stylesheet_contents", styleSheet)
 	if reason != "" {
 		form.Set("reason", reason)
@@ -1024,7 +1215,7 @@ func (s *SubredditService) uploadImage(ctx context.Context, subreddit, imagePath
 	form.Set("img_type", "png")
 
 	ext := filepath.Ext(file.Name())
-	if strings.EqualFold(ext, ".jpg") {
+	if strings.EqualFold(ext, ".jpg") || strings.EqualFold(ext, ".jpeg") {
 		form.Set("img_type", "jpg")
 	}
 
@@ -1107,6 +1298,9 @@ func (s *SubredditService) UploadMobileIcon(ctx context.Context, subreddit, imag
 
 // Create a subreddit.
 func (s *SubredditService) Create(ctx context.Context, name string, request *SubredditSettings) (*Response, error) {
+	if name == "" {
+		return nil, errors.New("name: cannot be empty")
+	}
 	if request == nil {
 		return nil, errors.New("*SubredditSettings: cannot be nil")
 	}
@@ -1127,6 +1321,16 @@ func (s *SubredditService) Create(ctx context.Context, name string, request *Sub
 	return s.client.Do(ctx, req, nil)
 }
 
+// CreateAndGet creates a subreddit and then gets it, since creating a subreddit
+// doesn't return it.
+func (s *SubredditService) CreateAndGet(ctx context.Context, name string, request *SubredditSettings) (*Subreddit, *Response, error) {
+	resp, err := s.Create(ctx, name, request)
+	if err != nil {
+		return nil, resp, err
+	}
+	return s.Get(ctx, name)
+}
+
 // Edit a subreddit.
 // This endpoint expects all values of the request to be provided.
 // To make this easier, it might be useful to get the subreddit's current settings via GetSettings(),
@@ -1152,6 +1356,45 @@ func (s *SubredditService) Edit(ctx context.Context, subredditID string, request
 	return s.client.Do(ctx, req, nil)
 }
 
+// Update a subreddit by applying the non-nil fields of patch onto its current settings.
+// Unlike Edit, the fields left nil in patch keep their current values.
+// It returns the settings that were sent.
+func (s *SubredditService) Update(ctx context.Context, subreddit string, patch *SubredditSettings) (*SubredditSettings, *Response, error) {
+	if patch == nil {
+		return nil, nil, errors.New("*SubredditSettings: cannot be nil")
+	}
+
+	settings, resp, err := s.GetSettings(ctx, subreddit)
+	if err != nil {
+		return nil, resp, err
+	}
+	if settings == nil {
+		return nil, resp, fmt.Errorf("could not get the settings of subreddit %q", subreddit)
+	}
+
+	settings.merge(patch)
+
+	resp, err = s.Edit(ctx, settings.ID, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settings, resp, nil
+}
+
+// merge sets the fields of s to those of patch that aren't nil.
+func (s *SubredditSettings) merge(patch *SubredditSettings) {
+	dst := reflect.ValueOf(s).Elem()
+	src := reflect.ValueOf(patch).Elem()
+
+	for i := 0; i < src.NumField(); i++ {
+		field := src.Field(i)
+		if field.Kind() == reflect.Ptr && !field.IsNil() {
+			dst.Field(i).Set(field)
+		}
+	}
+}
+
 // GetSettings gets the settings of a subreddit.
 func (s *SubredditService) GetSettings(ctx context.Context, subreddit string) (*SubredditSettings, *Response, error) {
 	path := fmt.Sprintf("r/%s/about/edit", subreddit)
@@ -2,16 +2,22 @@ package reddit
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/go-querystring/query"
 	"github.com/stretchr/testify/require"
 )
 
@@ -42,6 +48,12 @@ var expectedPosts = []*Post{
 
 		IsSelfPost: true,
 		Stickied:   true,
+
+		Thumbnail:          "self",
+		LinkFlairTextColor: "dark",
+		NumberOfCrossposts: 7,
+		IsCrosspostable:    true,
+		AllAwardings:       []*Awarding{},
 	},
 	{
 		ID:      "hyhquk",
@@ -65,6 +77,30 @@ var expectedPosts = []*Post{
 
 		Author:   "MuckleMcDuckle",
 		AuthorID: "t2_6fqntbwq",
+
+		Thumbnail:          "https://b.thumbs.redditmedia.com/rg4Aa--ZrHz2PNrmZbBk1cxajQrkRv2cvx2uhp7SSFo.jpg",
+		ThumbnailWidth:     140,
+		ThumbnailHeight:    140,
+		PostHint:           "image",
+		LinkFlairTextColor: "dark",
+		IsCrosspostable:    true,
+		AllAwardings:       []*Awarding{},
+
+		Preview: &PostPreview{
+			Images: []*PostPreviewImage{
+				{
+					ID:     "bxde3rpzP-mqawZJwpBIzEiH1y9nOLW3n1ghq9FPAR8",
+					Source: &PostPreviewImageSource{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?auto=webp&amp;s=f5103946eee4586cba8a1ba410e3098e9a14bb58", Width: 720, Height: 859},
+					Resolutions: []*PostPreviewImageSource{
+						{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=108&amp;crop=smart&amp;auto=webp&amp;s=a6904af790568dcea8fd3566e5d469df88a3891d", Width: 108, Height: 128},
+						{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=216&amp;crop=smart&amp;auto=webp&amp;s=09720b85b3b469b37030db3e3a5ab7fa231480f9", Width: 216, Height: 257},
+						{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=320&amp;crop=smart&amp;auto=webp&amp;s=78ace2e1c15e0e82dcfc95574d3ea3756812fd98", Width: 320, Height: 381},
+						{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=640&amp;crop=smart&amp;auto=webp&amp;s=d5d5305e3d97553176170ead8462cc0d155a7793", Width: 640, Height: 763},
+					},
+				},
+			},
+			Enabled: true,
+		},
 	},
 }
 
@@ -80,11 +116,25 @@ var expectedSubreddit = &Subreddit{
 	Description:  "Ask questions and post articles about the Go programming language and related tools, events etc.",
 	Type:         "public",
 
+	SubmissionType: "any",
+	Language:       "en",
+
+	CommunityIcon:         "https://styles.redditmedia.com/t5_2rc7j/styles/communityIcon_wy4riduoe9k11.png?width=256&amp;s=0d681daaa8d4b6271e6be788d0f9379f0661e04a",
+	HeaderImage:           "https://b.thumbs.redditmedia.com/7BDtSXbohQaPFuaa6oCA5HtE53Flgld6rj3G7-TavDs.png",
+	BannerBackgroundImage: "https://styles.redditmedia.com/t5_2rc7j/styles/bannerBackgroundImage_k15p9ugyd9k11.png?width=4000&amp;s=dc19f23446f14c3dee0ab59c538fd5dfb243eeb9",
+
 	Subscribers:     116532,
 	ActiveUserCount: Int(386),
 	NSFW:            false,
 	UserIsMod:       false,
 	Subscribed:      true,
+
+	AllowImages:     true,
+	AllowVideos:     true,
+	AllowVideoGIFs:  true,
+	AllowGalleries:  true,
+	AllowPolls:      true,
+	SpoilersEnabled: true,
 }
 
 var expectedSubreddits = []*Subreddit{
@@ -99,6 +149,16 @@ var expectedSubreddits = []*Subreddit{
 		Title:        "Home",
 		Type:         "public",
 
+		SubmissionType: "any",
+		Language:       "en",
+
+		AllowImages:     true,
+		AllowVideos:     true,
+		AllowVideoGIFs:  true,
+		AllowGalleries:  true,
+		AllowPolls:      true,
+		SpoilersEnabled: true,
+
 		Subscribers: 15336,
 		NSFW:        false,
 		UserIsMod:   false,
@@ -117,6 +177,18 @@ var expectedSubreddits = []*Subreddit{
 		Description:  "r/AskReddit is the place to ask and answer thought-provoking questions.",
 		Type:         "public",
 
+		SubmissionType: "self",
+		Language:       "es",
+		IconImage:      "https://b.thumbs.redditmedia.com/EndDxMGB-FTZ2MGtjepQ06cQEkZw_YQAsOUudpb9nSQ.png",
+		CommunityIcon:  "https://styles.redditmedia.com/t5_2qh1i/styles/communityIcon_tijjpyw1qe201.png?width=256&amp;s=4e76eadc662b8155a93d4d7487a6d3acb35f4334",
+		HeaderImage:    "https://a.thumbs.redditmedia.com/IrfPJGuWzi_ewrDTBlnULeZsJYGz81hsSQoQJyw6LD8.png",
+		BannerImage:    "https://b.thumbs.redditmedia.com/PXt8GnqdYu-9lgzb3iesJBLN21bXExRV1A45zdw4sYE.png",
+		KeyColor:       "#222222",
+		PrimaryColor:   "#646d73",
+
+		AllowGalleries:  true,
+		SpoilersEnabled: true,
+
 		Subscribers: 28449174,
 		NSFW:        false,
 		UserIsMod:   false,
@@ -135,6 +207,18 @@ var expectedSubreddits = []*Subreddit{
 		Description:  "A place for pictures and photographs.",
 		Type:         "public",
 
+		SubmissionType: "link",
+		Language:       "en",
+		IconImage:      "https://b.thumbs.redditmedia.com/VZX_KQLnI1DPhlEZ07bIcLzwR1Win808RIt7zm49VIQ.png",
+		HeaderImage:    "https://b.thumbs.redditmedia.com/1zT3FeN8pCAFIooNVuyuZ0ObU0x1ro4wPfArGHl3KjM.png",
+		KeyColor:       "#222222",
+		PrimaryColor:   "#cee3f8",
+
+		AllowImages:     true,
+		AllowVideoGIFs:  true,
+		AllowGalleries:  true,
+		SpoilersEnabled: true,
+
 		Subscribers: 24987753,
 		NSFW:        false,
 		UserIsMod:   false,
@@ -176,6 +260,164 @@ var expectedSearchPosts = []*Post{
 
 		Author:   "chocolat_ice_cream",
 		AuthorID: "t2_3p32m02",
+
+		Thumbnail:           "https://a.thumbs.redditmedia.com/mTY7zZSrlStun4i_rAehBJN556LUwky1PUbIQhrVvC8.jpg",
+		ThumbnailWidth:      140,
+		ThumbnailHeight:     140,
+		PostHint:            "hosted:video",
+		LinkFlairTextColor:  "dark",
+		NumberOfCrossposts:  20,
+		TotalAwardsReceived: 23,
+		IsVideo:             true,
+		IsCrosspostable:     true,
+		AllAwardings: []*Awarding{
+			{
+				Award: Award{
+					ID:          "award_9663243a-e77f-44cf-abc6-850ead2cd18d",
+					Name:        "Bravo Grande!",
+					Description: "For an especially amazing showing.",
+					Type:        "global",
+					CoinPrice:   75,
+					IconURL:     "https://www.redditstatic.com/gold/awards/icon/SnooClappingPremium_512.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "award_a2506925-fc82-4d6c-ae3b-b7217e09d7f0",
+					Name:        "Narwhal Salute",
+					Description: "A golden splash of respect",
+					Type:        "global",
+					CoinPrice:   30,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/80j20o397jj41_NarwhalSalute.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "award_b4ff447e-05a5-42dc-9002-63568807cfe6",
+					Name:        "All-Seeing Upvote",
+					Description: "A glowing commendation for all to see",
+					Type:        "global",
+					CoinPrice:   30,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/rg960rc47jj41_All-SeeingUpvote.png",
+				},
+				Count: 2,
+			},
+			{
+				Award: Award{
+					ID:          "gid_3",
+					Name:        "Platinum",
+					Description: "Gives the author a month of Reddit Premium, which includes %{coin_symbol}700 Coins for that month, and shows a Platinum Award.",
+					Type:        "global",
+					CoinPrice:   1800,
+					IconURL:     "https://www.redditstatic.com/gold/awards/icon/platinum_512.png",
+				},
+				Count: 2,
+			},
+			{
+				Award: Award{
+					ID:          "gid_2",
+					Name:        "Gold",
+					Description: "Gives the author a week of Reddit Premium, %{coin_symbol}100 Coins to do with as they please, and shows a Gold Award.",
+					Type:        "global",
+					CoinPrice:   500,
+					IconURL:     "https://www.redditstatic.com/gold/awards/icon/gold_512.png",
+				},
+				Count: 4,
+			},
+			{
+				Award: Award{
+					ID:          "award_b28d9565-4137-433d-bb65-5d4aa82ade4c",
+					Name:        "I'm Deceased",
+					Description: "Call an ambulance, I'm laughing too hard.",
+					Type:        "global",
+					CoinPrice:   200,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/2jd92wtn25g41_ImDeceased.png",
+				},
+				Count: 3,
+			},
+			{
+				Award: Award{
+					ID:          "award_88fdcafc-57a0-48db-99cc-76276bfaf28b",
+					Name:        "Press F",
+					Description: "To pay respects.",
+					Type:        "global",
+					CoinPrice:   150,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/tcofsbf92md41_PressF.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "award_77ba55a2-c33c-4351-ac49-807455a80148",
+					Name:        "Bless Up",
+					Description: "Prayers up for the blessed.",
+					Type:        "global",
+					CoinPrice:   150,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/trfv6ems1md41_BlessUp.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "gid_1",
+					Name:        "Silver",
+					Description: "Shows the Silver Award... and that's it.",
+					Type:        "global",
+					CoinPrice:   100,
+					IconURL:     "https://www.redditstatic.com/gold/awards/icon/silver_512.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "award_7becef23-fb0b-4d62-b8a6-01d5759367cb",
+					Name:        "Faith In Humanity Restored",
+					Description: "When goodness lifts you",
+					Type:        "global",
+					CoinPrice:   70,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/gva4vt20qc751_FaithInHumanityRestored.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "award_02d9ab2c-162e-4c01-8438-317a016ed3d9",
+					Name:        "Take My Energy",
+					Description: "I'm in this with you.",
+					Type:        "global",
+					CoinPrice:   50,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/898sygoknoo41_TakeMyEnergy.png",
+				},
+				Count: 5,
+			},
+			{
+				Award: Award{
+					ID:          "award_69c94eb4-d6a3-48e7-9cf2-0f39fed8b87c",
+					Name:        "Ally",
+					Description: "Listen, get educated, and get involved.",
+					Type:        "global",
+					CoinPrice:   50,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/5nswjpyy44551_Ally.png",
+				},
+				Count: 1,
+			},
+		},
+
+		Preview: &PostPreview{
+			Images: []*PostPreviewImage{
+				{
+					ID:     "6MEEtWN_cm1lRDpu_daXxHcau23YIWh0FeiB96IPgJs",
+					Source: &PostPreviewImageSource{URL: "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?format=pjpg&amp;auto=webp&amp;s=dbe1004d6df4fb6014d78e0c0d817c1106f1f3b2", Width: 360, Height: 360},
+					Resolutions: []*PostPreviewImageSource{
+						{URL: "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?width=108&amp;crop=smart&amp;format=pjpg&amp;auto=webp&amp;s=3de4a7249f291b848838f865bb592f7e51555e96", Width: 108, Height: 108},
+						{URL: "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?width=216&amp;crop=smart&amp;format=pjpg&amp;auto=webp&amp;s=531916387899ed20e33386081b5d5c58a73be188", Width: 216, Height: 216},
+						{URL: "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?width=320&amp;crop=smart&amp;format=pjpg&amp;auto=webp&amp;s=4d19996fba95dae7fb615cdc102d34c8bfb44e0a", Width: 320, Height: 320},
+					},
+				},
+			},
+		},
 	},
 	{
 		ID:      "hmwhd7",
@@ -199,6 +441,299 @@ var expectedSearchPosts = []*Post{
 
 		Author:   "Jeremy_Martin",
 		AuthorID: "t2_wgrkg",
+
+		Thumbnail:           "default",
+		ThumbnailWidth:      140,
+		ThumbnailHeight:     73,
+		PostHint:            "link",
+		LinkFlairText:       "COVID-19",
+		LinkFlairTextColor:  "dark",
+		NumberOfCrossposts:  22,
+		TotalAwardsReceived: 60,
+		IsCrosspostable:     true,
+		AllAwardings: []*Awarding{
+			{
+				Award: Award{
+					ID:          "award_6001deaa-c9e0-4914-ab3d-7c4a16bd8617",
+					Name:        "Fireworks",
+					Description: "Bonfires and illuminations are still going strong. Happy 4th of July!",
+					Type:        "global",
+					CoinPrice:   100,
+					IconURL:     "https://www.redditstatic.com/gold/awards/icon/Fireworks_512.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "award_92cb6518-a71a-4217-9f8f-7ecbd7ab12ba",
+					Name:        "Take My Power",
+					Description: "Add my power to yours.",
+					Type:        "global",
+					CoinPrice:   75,
+					IconURL:     "https://www.redditstatic.com/gold/awards/icon/TakeMyPower_512.png",
+				},
+				Count: 2,
+			},
+			{
+				Award: Award{
+					ID:          "award_9663243a-e77f-44cf-abc6-850ead2cd18d",
+					Name:        "Bravo Grande!",
+					Description: "For an especially amazing showing.",
+					Type:        "global",
+					CoinPrice:   75,
+					IconURL:     "https://www.redditstatic.com/gold/awards/icon/SnooClappingPremium_512.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "award_c4b2e438-16bb-4568-88e7-7893b7662944",
+					Name:        "Wholesome Seal of Approval",
+					Description: "A glittering stamp for a feel-good thing",
+					Type:        "global",
+					CoinPrice:   30,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/b9ks3a5k7jj41_WholesomeSealofApproval.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "award_b4ff447e-05a5-42dc-9002-63568807cfe6",
+					Name:        "All-Seeing Upvote",
+					Description: "A glowing commendation for all to see",
+					Type:        "global",
+					CoinPrice:   30,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/rg960rc47jj41_All-SeeingUpvote.png",
+				},
+				Count: 2,
+			},
+			{
+				Award: Award{
+					ID:          "award_d48aad4b-286f-4a3a-bb41-ec05b3cd87cc",
+					Name:        "Yas Queen",
+					Description: "YAAAAAAAAAAASSS.",
+					Type:        "global",
+					CoinPrice:   250,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/kthj3e4h3bm41_YasQueen.png",
+				},
+				Count: 2,
+			},
+			{
+				Award: Award{
+					ID:          "gid_3",
+					Name:        "Platinum",
+					Description: "Gives the author a month of Reddit Premium, which includes %{coin_symbol}700 Coins for that month, and shows a Platinum Award.",
+					Type:        "global",
+					CoinPrice:   1800,
+					IconURL:     "https://www.redditstatic.com/gold/awards/icon/platinum_512.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "gid_2",
+					Name:        "Gold",
+					Description: "Gives the author a week of Reddit Premium, %{coin_symbol}100 Coins to do with as they please, and shows a Gold Award.",
+					Type:        "global",
+					CoinPrice:   500,
+					IconURL:     "https://www.redditstatic.com/gold/awards/icon/gold_512.png",
+				},
+				Count: 3,
+			},
+			{
+				Award: Award{
+					ID:          "award_43c43a35-15c5-4f73-91ef-fe538426435a",
+					Name:        "Bless Up (Pro)",
+					Description: "Prayers up for the blessed. Gives %{coin_symbol}100 Coins to both the author and the community.",
+					Type:        "global",
+					CoinPrice:   500,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/xe5mw55w5v541_BlessUp.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "award_5b39e8fd-7a58-4cbe-8ca0-bdedd5ed1f5a",
+					Name:        "Doot 🎵 Doot",
+					Description: "Sometimes you just got to dance with the doots.",
+					Type:        "global",
+					CoinPrice:   400,
+					IconURL:     "https://www.redditstatic.com/gold/awards/icon/Updoot_512.png",
+				},
+				Count: 6,
+			},
+			{
+				Award: Award{
+					ID:          "award_725b427d-320b-4d02-8fb0-8bb7aa7b78aa",
+					Name:        "Updoot",
+					Description: "Sometimes you just got to doot.",
+					Type:        "global",
+					CoinPrice:   300,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/7atjjqpy1mc41_Updoot.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "award_d125d124-5c03-490d-af3d-d07c462003da",
+					Name:        "Stonks Rising",
+					Description: "To the MOON.",
+					Type:        "global",
+					CoinPrice:   200,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/s5edqq9abef41_StonksRising.png",
+				},
+				Count: 2,
+			},
+			{
+				Award: Award{
+					ID:          "award_b28d9565-4137-433d-bb65-5d4aa82ade4c",
+					Name:        "I'm Deceased",
+					Description: "Call an ambulance, I'm laughing too hard.",
+					Type:        "global",
+					CoinPrice:   200,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/2jd92wtn25g41_ImDeceased.png",
+				},
+				Count: 7,
+			},
+			{
+				Award: Award{
+					ID:          "award_88fdcafc-57a0-48db-99cc-76276bfaf28b",
+					Name:        "Press F",
+					Description: "To pay respects.",
+					Type:        "global",
+					CoinPrice:   150,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/tcofsbf92md41_PressF.png",
+				},
+				Count: 4,
+			},
+			{
+				Award: Award{
+					ID:          "award_5f123e3d-4f48-42f4-9c11-e98b566d5897",
+					Name:        "Wholesome",
+					Description: "When you come across a feel-good thing.",
+					Type:        "global",
+					CoinPrice:   125,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/5izbv4fn0md41_Wholesome.png",
+				},
+				Count: 5,
+			},
+			{
+				Award: Award{
+					ID:          "gid_1",
+					Name:        "Silver",
+					Description: "Shows the Silver Award... and that's it.",
+					Type:        "global",
+					CoinPrice:   100,
+					IconURL:     "https://www.redditstatic.com/gold/awards/icon/silver_512.png",
+				},
+				Count: 2,
+			},
+			{
+				Award: Award{
+					ID:          "award_99d95969-6100-45b2-b00c-0ec45ae19596",
+					Name:        "Snek",
+					Description: "A smol, delicate danger noodle.",
+					Type:        "global",
+					CoinPrice:   70,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/rc5iesz2z8t41_Snek.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "award_7becef23-fb0b-4d62-b8a6-01d5759367cb",
+					Name:        "Faith In Humanity Restored",
+					Description: "When goodness lifts you",
+					Type:        "global",
+					CoinPrice:   70,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/gva4vt20qc751_FaithInHumanityRestored.png",
+				},
+				Count: 2,
+			},
+			{
+				Award: Award{
+					ID:          "award_b1b44fa1-8179-4d84-a9ed-f25bb81f1c5f",
+					Name:        "Facepalm",
+					Description: "*Lowers face into palm*",
+					Type:        "global",
+					CoinPrice:   70,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/ey2iodron2s41_Facepalm.png",
+				},
+				Count: 3,
+			},
+			{
+				Award: Award{
+					ID:          "award_02d9ab2c-162e-4c01-8438-317a016ed3d9",
+					Name:        "Take My Energy",
+					Description: "I'm in this with you.",
+					Type:        "global",
+					CoinPrice:   50,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/898sygoknoo41_TakeMyEnergy.png",
+				},
+				Count: 2,
+			},
+			{
+				Award: Award{
+					ID:          "award_fcccaa58-8f63-4d9d-9251-81033cd0daa3",
+					Name:        "Nothing To Do",
+					Description: "I've got nothing to do, and I'm trying to do nothing.",
+					Type:        "global",
+					CoinPrice:   50,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/1snr345pm1w41_NothingToDo.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "award_cc091963-e271-45aa-ba23-b5150e565520",
+					Name:        "Safe &amp; Social",
+					Description: "Connecting together responsibly",
+					Type:        "global",
+					CoinPrice:   30,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/qq73pijkm3p41_SafeSocial.png",
+				},
+				Count: 1,
+			},
+			{
+				Award: Award{
+					ID:          "award_3cf96da4-79da-4127-90ac-84545e1833dc",
+					Name:        "Home Time",
+					Description: "Staying home &amp; being safe when you can",
+					Type:        "global",
+					CoinPrice:   30,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/qh4pzo76v9p41_HomeTime.png",
+				},
+				Count: 2,
+			},
+			{
+				Award: Award{
+					ID:          "award_a903c949-ccc5-420d-8239-1bbefc424838",
+					Name:        "Healthcare Hero",
+					Description: "Putting yourself on the line for us - you are the perfect super hero!",
+					Type:        "global",
+					CoinPrice:   30,
+					IconURL:     "https://i.redd.it/award_images/t5_22cerq/xs2na1t1v9p41_HealthcareHero.png",
+				},
+				Count: 7,
+			},
+		},
+
+		Preview: &PostPreview{
+			Images: []*PostPreviewImage{
+				{
+					ID:     "Ug52cYq0iihKhNVnhJnu_b8ThcVTp27Yjit2korgoUo",
+					Source: &PostPreviewImageSource{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?auto=webp&amp;s=bcb266e3d2f9b1b8410b8ebc1ba112461ac7c89b", Width: 1200, Height: 630},
+					Resolutions: []*PostPreviewImageSource{
+						{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=108&amp;crop=smart&amp;auto=webp&amp;s=8cd17cff83d56ad74566088b46a5f656c4e6233b", Width: 108, Height: 56},
+						{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=216&amp;crop=smart&amp;auto=webp&amp;s=279340e68ef64a890709218d27e805e40ef2d1d5", Width: 216, Height: 113},
+						{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=320&amp;crop=smart&amp;auto=webp&amp;s=a57f95db845046e7d75af256fed8a2fab65dec60", Width: 320, Height: 168},
+						{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=640&amp;crop=smart&amp;auto=webp&amp;s=6fc8a7055610d03faaa3b0f32ba521a99b5c2bdd", Width: 640, Height: 336},
+						{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=960&amp;crop=smart&amp;auto=webp&amp;s=be77436ac80c45b2153de325008085920d8d8489", Width: 960, Height: 504},
+						{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=1080&amp;crop=smart&amp;auto=webp&amp;s=71644306bcb0036f2d8ee5bf878e3c78f6c3012c", Width: 1080, Height: 567},
+					},
+				},
+			},
+		},
 	},
 }
 
@@ -213,6 +748,10 @@ var expectedRandomSubreddit = &Subreddit{
 	Description:  "The only place for news, discussion, photos, and everything else Samsung Galaxy S8.",
 	Type:         "public",
 
+	IconImage:    "https://b.thumbs.redditmedia.com/4hg41g2_X1R5S_HTUscWCK_7iAo6SPdag_oOlSx7WAM.png",
+	HeaderImage:  "https://b.thumbs.redditmedia.com/AfySt3BMPjuq79LOh84X4uomahu0JE8DLaJZMenG-5I.png",
+	PrimaryColor: "#373c3f",
+
 	Subscribers: 52357,
 }
 
@@ -451,6 +990,35 @@ func TestSubredditService_HotPosts(t *testing.T) {
 	require.Equal(t, "t3_hyhquk", resp.After)
 }
 
+func TestSubredditService_HotPostsInRegion(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/hot", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("g", "US_NY")
+		form.Set("limit", "10")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.HotPostsInRegion(ctx, "test", "ZZ", nil)
+	require.EqualError(t, err, `region: "ZZ" is not a valid region code`)
+
+	posts, resp, err := client.Subreddit.HotPostsInRegion(ctx, "test", "US_NY", &ListOptions{Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+	require.Equal(t, "t3_hyhquk", resp.After)
+}
+
 func TestSubredditService_NewPosts(t *testing.T) {
 	client, mux := setup(t)
 
@@ -538,6 +1106,82 @@ func TestSubredditService_Get(t *testing.T) {
 	require.Equal(t, expectedSubreddit, subreddit)
 }
 
+func TestSubredditService_SubscriberCount(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/about.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/golang/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.SubscriberCount(ctx, "")
+	require.EqualError(t, err, "name: cannot be empty")
+
+	count, _, err := client.Subreddit.SubscriberCount(ctx, "golang")
+	require.NoError(t, err)
+	require.Equal(t, 116532, count)
+}
+
+func TestSubredditService_GetAboutV2(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/about-v2.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/golang/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.GetAboutV2(ctx, "")
+	require.EqualError(t, err, "name: cannot be empty")
+
+	about, _, err := client.Subreddit.GetAboutV2(ctx, "golang")
+	require.NoError(t, err)
+	require.Equal(t, &SubredditAboutV2{
+		ID:          "2qh1i",
+		Name:        "golang",
+		Title:       "The Go Programming Language",
+		Description: "Ask questions and post articles about the Go programming language and related tools.",
+		Created:     &Timestamp{time.Date(2009, 11, 10, 0, 6, 57, 0, time.UTC)},
+		Subscribers: 193862,
+
+		AllowedPostTypes: []string{"text", "link", "image"},
+		FlairTypes:       []string{"post"},
+
+		Rules: []*SubredditRule{
+			{
+				Kind:            "all",
+				Name:            "Be friendly",
+				ViolationReason: "Be friendly",
+				Description:     "Treat other members of the community with respect.",
+				Priority:        0,
+				Created:         &Timestamp{time.Date(2020, 6, 2, 21, 36, 12, 0, time.UTC)},
+			},
+		},
+		Widgets: WidgetList{
+			&TextAreaWidget{
+				widget: widget{
+					ID:   "widget_15p7borvnnw5a",
+					Kind: "textarea",
+					Style: &WidgetStyle{
+						HeaderColor:     "#373c3f",
+						BackgroundColor: "#cc5289",
+					},
+				},
+				Name: "test title",
+				Text: "test text",
+			},
+		},
+
+		ContentCategory: "technology",
+		Topics:          []string{"programming", "software development"},
+	}, about)
+}
+
 func TestSubredditService_Popular(t *testing.T) {
 	client, mux := setup(t)
 
@@ -546,10 +1190,21 @@ func TestSubredditService_Popular(t *testing.T) {
 
 	mux.HandleFunc("/subreddits/popular", func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "5")
+		form.Set("after", "t5_test")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
 		fmt.Fprint(w, blob)
 	})
 
-	subreddits, resp, err := client.Subreddit.Popular(ctx, nil)
+	subreddits, resp, err := client.Subreddit.Popular(ctx, &ListSubredditOptions{
+		ListOptions: ListOptions{Limit: 5, After: "t5_test"},
+	})
 	require.NoError(t, err)
 	require.Equal(t, expectedSubreddits, subreddits)
 	require.Equal(t, "t5_2qh0u", resp.After)
@@ -563,10 +1218,21 @@ func TestSubredditService_New(t *testing.T) {
 
 	mux.HandleFunc("/subreddits/new", func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "5")
+		form.Set("after", "t5_test")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
 		fmt.Fprint(w, blob)
 	})
 
-	subreddits, resp, err := client.Subreddit.New(ctx, nil)
+	subreddits, resp, err := client.Subreddit.New(ctx, &ListSubredditOptions{
+		ListOptions: ListOptions{Limit: 5, After: "t5_test"},
+	})
 	require.NoError(t, err)
 	require.Equal(t, expectedSubreddits, subreddits)
 	require.Equal(t, "t5_2qh0u", resp.After)
@@ -623,6 +1289,63 @@ func TestSubredditService_Subscribed(t *testing.T) {
 	require.Equal(t, "t5_2qh0u", resp.After)
 }
 
+var expectedLastPageSubreddit = &Subreddit{
+	ID:      "2rc7j",
+	FullID:  "t5_2rc7j",
+	Created: &Timestamp{time.Date(2009, 11, 16, 18, 27, 24, 0, time.UTC)},
+
+	URL:          "/r/golang/",
+	Name:         "golang",
+	NamePrefixed: "r/golang",
+	Title:        "The Go Programming Language",
+	Type:         "public",
+
+	Subscribers: 152347,
+	Subscribed:  true,
+}
+
+func TestSubredditService_AllSubscribed(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/list.json")
+	require.NoError(t, err)
+
+	lastPageBlob, err := readFileContents("../testdata/subreddit/list-last-page.json")
+	require.NoError(t, err)
+
+	var pages int
+	mux.HandleFunc("/subreddits/mine/subscriber", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		pages++
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "100", r.Form.Get("limit"))
+
+		switch r.Form.Get("after") {
+		case "":
+			fmt.Fprint(w, blob)
+		case "t5_2qh0u":
+			fmt.Fprint(w, lastPageBlob)
+		default:
+			t.Errorf("unexpected after: %q", r.Form.Get("after"))
+		}
+	})
+
+	subreddits, resp, err := client.Subreddit.AllSubscribed(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, pages)
+	require.Equal(t, append(append([]*Subreddit{}, expectedSubreddits...), expectedLastPageSubreddit), subreddits)
+	require.Empty(t, resp.After)
+
+	// the first page has enough subreddits
+	pages = 0
+	subreddits, _, err = client.Subreddit.AllSubscribed(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, pages)
+	require.Equal(t, expectedSubreddits[:2], subreddits)
+}
+
 func TestSubredditService_Approved(t *testing.T) {
 	client, mux := setup(t)
 
@@ -657,6 +1380,88 @@ func TestSubredditService_Moderated(t *testing.T) {
 	require.Equal(t, "t5_2qh0u", resp.After)
 }
 
+func TestSubredditService_AllSubscribed_CancelledMidway(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/list.json")
+	require.NoError(t, err)
+
+	cancellableCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var pages int
+	mux.HandleFunc("/subreddits/mine/subscriber", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		pages++
+
+		// the first page is delivered, but the caller gives up before asking for the next one
+		fmt.Fprint(w, blob)
+		cancel()
+	})
+
+	subreddits, _, err := client.Subreddit.AllSubscribed(cancellableCtx, 0)
+	require.True(t, errors.Is(err, context.Canceled), "expected context.Canceled, got: %v", err)
+	require.Nil(t, subreddits)
+	require.Equal(t, 1, pages)
+}
+
+func TestSubredditService_AllModerated(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/moderated.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/subreddits/mine/moderator", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "100")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	subreddits, _, err := client.Subreddit.AllModerated(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, []*Subreddit{
+		{
+			ID:      "test1",
+			FullID:  "t5_test1",
+			Created: &Timestamp{time.Date(2020, 6, 28, 16, 43, 55, 0, time.UTC)},
+
+			URL:          "/r/test/",
+			Name:         "test",
+			NamePrefixed: "r/test",
+			Title:        "Test",
+			Type:         "private",
+
+			Subscribers:    10,
+			UserIsMod:      true,
+			Subscribed:     true,
+			ModPermissions: []string{"all"},
+		},
+		{
+			ID:      "test2",
+			FullID:  "t5_test2",
+			Created: &Timestamp{time.Date(2020, 6, 28, 16, 44, 2, 0, time.UTC)},
+
+			URL:          "/r/test2/",
+			Name:         "test2",
+			NamePrefixed: "r/test2",
+			Title:        "Test 2",
+			Type:         "public",
+
+			Subscribers:    2,
+			NSFW:           true,
+			UserIsMod:      true,
+			ModPermissions: []string{"posts", "flair"},
+		},
+	}, subreddits)
+}
+
 func TestSubredditService_GetSticky1(t *testing.T) {
 	client, mux := setup(t)
 
@@ -871,6 +1676,50 @@ func TestSubredditService_SearchNames(t *testing.T) {
 	require.Equal(t, expectedSubredditNames, names)
 }
 
+func TestSubredditService_Search_EscapesQuery(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/list.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/subreddits/search", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("q", "c++ & go#1")
+		form.Set("limit", "10")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	mux.HandleFunc("/api/search_reddit_names", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("query", "c++ & go#1")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, `{"names": []}`)
+	})
+
+	_, _, err = client.Subreddit.Search(ctx, "c++ & go#1", &ListSubredditOptions{
+		ListOptions: ListOptions{
+			Limit: 10,
+		},
+	})
+	require.NoError(t, err)
+
+	_, _, err = client.Subreddit.SearchNames(ctx, "c++ & go#1")
+	require.NoError(t, err)
+}
+
 func TestSubredditService_SearchPosts(t *testing.T) {
 	client, mux := setup(t)
 
@@ -896,6 +1745,58 @@ func TestSubredditService_SearchPosts(t *testing.T) {
 	require.Equal(t, "t3_hmwhd7", resp.After)
 }
 
+func TestSubredditService_SearchPostsWithFacets(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/search-posts-facets.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/all/search", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("q", "test")
+		form.Set("include_facets", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, facets, resp, err := client.Subreddit.SearchPostsWithFacets(ctx, "test", "", nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedSearchPosts, posts)
+	require.Equal(t, &SearchFacets{
+		Subreddits: []*SearchFacet{
+			{Name: "golang", Count: 12},
+			{Name: "test", Count: 3},
+		},
+		Profiles: []*SearchFacet{
+			{Name: "v_95", Count: 2},
+		},
+	}, facets)
+	require.Equal(t, "t3_hmwhd7", resp.After)
+}
+
+func TestSubredditService_SearchPostsWithFacets_Empty(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/search-posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/all/search", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	posts, facets, _, err := client.Subreddit.SearchPostsWithFacets(ctx, "test", "", nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedSearchPosts, posts)
+	require.Equal(t, &SearchFacets{}, facets)
+}
+
 func TestSubredditService_SearchPosts_InSubreddit(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1264,6 +2165,54 @@ func TestSubredditService_StyleSheetRaw(t *testing.T) {
 	require.Equal(t, "* { box-sizing: border-box; }", styleSheet)
 }
 
+func TestSubredditService_StyleSheetURL(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/stylesheet", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		w.Header().Set("Location", "https://b.thumbs.redditmedia.com/testsubreddit.css")
+		w.WriteHeader(http.StatusFound)
+	})
+
+	styleSheetURL, _, err := client.Subreddit.StyleSheetURL(ctx, "testsubreddit")
+	require.NoError(t, err)
+	require.Equal(t, "https://b.thumbs.redditmedia.com/testsubreddit.css", styleSheetURL)
+}
+
+func TestSubredditService_StyleSheetURL_Readonly(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/r/testsubreddit/stylesheet.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		http.Redirect(w, r, "/stylesheets/testsubreddit.css", http.StatusFound)
+	})
+
+	mux.HandleFunc("/stylesheets/testsubreddit.css", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the redirect should not be followed")
+	})
+
+	client, err := NewReadonlyClient(WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	styleSheetURL, _, err := client.Subreddit.StyleSheetURL(ctx, "testsubreddit")
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/stylesheets/testsubreddit.css", styleSheetURL)
+}
+
+func TestSubredditService_StyleSheetURL_NoRedirect(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/stylesheet", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, "* { box-sizing: border-box; }")
+	})
+
+	_, _, err := client.Subreddit.StyleSheetURL(ctx, "testsubreddit")
+	require.EqualError(t, err, "expected a redirect to the style sheet, got status 200")
+}
+
 func TestSubredditService_UpdateStyleSheet(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1285,6 +2234,26 @@ func TestSubredditService_UpdateStyleSheet(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSubredditService_PreviewStyleSheet(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/subreddit_stylesheet", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("op", "preview")
+		form.Set("stylesheet_contents", "* { box-sizing: border-box; }")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Subreddit.PreviewStyleSheet(ctx, "testsubreddit", "* { box-sizing: border-box; }")
+	require.NoError(t, err)
+}
+
 func TestSubredditService_RemoveImage(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1404,6 +2373,45 @@ func TestSubredditService_UploadImage(t *testing.T) {
 	require.Equal(t, "https://example.com/test.png", link)
 }
 
+func TestSubredditService_UploadImage_Multipart(t *testing.T) {
+	client, mux := setup(t)
+
+	imageFile, err := ioutil.TempFile("/tmp", "image*.JPEG")
+	require.NoError(t, err)
+	defer func() {
+		imageFile.Close()
+		os.Remove(imageFile.Name())
+	}()
+
+	_, err = imageFile.WriteString("this is a test")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/api/upload_sr_img", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		require.Equal(t, "multipart/form-data", mediaType)
+		require.NotEmpty(t, params["boundary"])
+
+		err = r.ParseMultipartForm(1 << 20)
+		require.NoError(t, err)
+		require.Equal(t, "jpg", r.MultipartForm.Value["img_type"][0])
+		require.Equal(t, "img", r.MultipartForm.Value["upload_type"][0])
+		require.Equal(t, "testname", r.MultipartForm.Value["name"][0])
+		require.Len(t, r.MultipartForm.File["file"], 1)
+		require.Equal(t, filepath.Base(imageFile.Name()), r.MultipartForm.File["file"][0].Filename)
+
+		fmt.Fprint(w, `{
+			"img_src": "https://example.com/test.jpg"
+		}`)
+	})
+
+	link, _, err := client.Subreddit.UploadImage(ctx, "testsubreddit", imageFile.Name(), "testname")
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/test.jpg", link)
+}
+
 func TestSubredditService_UploadHeader(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1629,6 +2637,63 @@ func TestSubredditService_Create(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSubredditService_Create_Exists(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/site_admin", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "json", r.PostForm.Get("api_type"))
+		require.Equal(t, "testsubreddit", r.PostForm.Get("name"))
+
+		fmt.Fprint(w, `{
+			"json": {
+				"errors": [
+					["SUBREDDIT_EXISTS", "that subreddit already exists", "name"]
+				]
+			}
+		}`)
+	})
+
+	_, err := client.Subreddit.Create(ctx, "testsubreddit", expectedSubredditSettings)
+	require.IsType(t, &JSONErrorResponse{}, err)
+	require.Equal(t, []APIError{{Label: "SUBREDDIT_EXISTS", Reason: "that subreddit already exists", Field: "name"}}, err.(*JSONErrorResponse).JSON.Errors)
+}
+
+func TestSubredditService_CreateAndGet(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/about.json")
+	require.NoError(t, err)
+
+	created := false
+	mux.HandleFunc("/api/site_admin", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "golang", r.PostForm.Get("name"))
+		require.Empty(t, r.PostForm.Get("sr"))
+
+		created = true
+	})
+
+	mux.HandleFunc("/r/golang/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.True(t, created, "subreddit was fetched before it was created")
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.CreateAndGet(ctx, "", expectedSubredditSettings)
+	require.EqualError(t, err, "name: cannot be empty")
+
+	subreddit, _, err := client.Subreddit.CreateAndGet(ctx, "golang", expectedSubredditSettings)
+	require.NoError(t, err)
+	require.Equal(t, expectedSubreddit, subreddit)
+}
+
 func TestSubredditService_Edit(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1705,6 +2770,53 @@ func TestSubredditService_GetSettings(t *testing.T) {
 	require.Equal(t, expectedSubredditSettings, subredditSettings)
 }
 
+func TestSubredditService_Update(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/settings.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/about/edit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	original, err := query.Values(expectedSubredditSettings)
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/site_admin", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		for key, values := range original {
+			form[key] = values
+		}
+		form.Set("api_type", "json")
+		form.Set("sr", expectedSubredditSettings.ID)
+		form.Set("title", "new title")
+		form.Set("over_18", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, _, err = client.Subreddit.Update(ctx, "testsubreddit", nil)
+	require.EqualError(t, err, "*SubredditSettings: cannot be nil")
+
+	settings, _, err := client.Subreddit.Update(ctx, "testsubreddit", &SubredditSettings{
+		Title: String("new title"),
+		NSFW:  Bool(true),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "new title", *settings.Title)
+	require.True(t, *settings.NSFW)
+	require.Equal(t, expectedSubredditSettings.Description, settings.Description)
+
+	// the expected settings must not have been modified
+	require.NotEqual(t, "new title", *expectedSubredditSettings.Title)
+}
+
 func TestSubredditService_PostRequirements(t *testing.T) {
 	client, mux := setup(t)
 
@@ -97,7 +97,7 @@ func (t *thing) UnmarshalJSON(b []byte) error {
 	case kindTrophyList:
 		v = new(trophyList)
 	case kindKarmaList:
-		v = new([]*SubredditKarma)
+		v = new(karmaList)
 	case kindWikiPage:
 		v = new(WikiPage)
 	case kindWikiPageListing:
@@ -196,7 +196,7 @@ func (t *thing) TrophyList() ([]*Trophy, bool) {
 }
 
 func (t *thing) Karma() ([]*SubredditKarma, bool) {
-	v, ok := t.Data.(*[]*SubredditKarma)
+	v, ok := t.Data.(*karmaList)
 	if !ok {
 		return nil, ok
 	}
@@ -231,6 +231,7 @@ func (t *thing) StyleSheet() (v *SubredditStyleSheet, ok bool) {
 type listing struct {
 	things things
 	after  string
+	facets *SearchFacets
 }
 
 func (l *listing) After() string {
@@ -240,8 +241,9 @@ func (l *listing) After() string {
 // UnmarshalJSON implements the json.Unmarshaler interface.
 func (l *listing) UnmarshalJSON(b []byte) error {
 	root := new(struct {
-		Things things `json:"children"`
-		After  string `json:"after"`
+		Things things        `json:"children"`
+		After  string        `json:"after"`
+		Facets *SearchFacets `json:"facets"`
 	})
 
 	err := json.Unmarshal(b, root)
@@ -251,6 +253,7 @@ func (l *listing) UnmarshalJSON(b []byte) error {
 
 	l.things = root.Things
 	l.after = root.After
+	l.facets = root.Facets
 
 	return nil
 }
@@ -389,6 +392,29 @@ func (l *trophyList) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+type karmaList []*SubredditKarma
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The karma breakdown is usually the array itself, but it can also
+// be wrapped in an object, under "children", like a listing.
+func (l *karmaList) UnmarshalJSON(b []byte) error {
+	var karma []*SubredditKarma
+	if len(b) > 0 && b[0] == '{' {
+		envelope := new(struct {
+			Children []*SubredditKarma `json:"children"`
+		})
+		if err := json.Unmarshal(b, envelope); err != nil {
+			return err
+		}
+		karma = envelope.Children
+	} else if err := json.Unmarshal(b, &karma); err != nil {
+		return err
+	}
+
+	*l = karma
+	return nil
+}
+
 // Comment is a comment posted by a user.
 type Comment struct {
 	ID      string     `json:"id,omitempty"`
@@ -405,6 +431,14 @@ type Comment struct {
 	AuthorFlairText string `json:"author_flair_text,omitempty"`
 	AuthorFlairID   string `json:"author_flair_template_id,omitempty"`
 
+	AuthorFlairBackgroundColor string `json:"author_flair_background_color,omitempty"`
+	// Either "dark" or "light".
+	AuthorFlairTextColor string              `json:"author_flair_text_color,omitempty"`
+	AuthorFlairRichtext  []map[string]string `json:"author_flair_richtext,omitempty"`
+
+	// Either "moderator" or "admin" if the author distinguished the comment.
+	Distinguished string `json:"distinguished,omitempty"`
+
 	SubredditName         string `json:"subreddit,omitempty"`
 	SubredditNamePrefixed string `json:"subreddit_name_prefixed,omitempty"`
 	SubredditID           string `json:"subreddit_id,omitempty"`
@@ -413,8 +447,14 @@ type Comment struct {
 	// If neither, it will be nil.
 	Likes *bool `json:"likes"`
 
-	Score            int `json:"score"`
-	Controversiality int `json:"controversiality"`
+	Score               int `json:"score"`
+	Controversiality    int `json:"controversiality"`
+	TotalAwardsReceived int `json:"total_awards_received"`
+
+	AllAwardings []*Awarding `json:"all_awardings,omitempty"`
+
+	// How deeply nested the comment is in its thread, starting at 0 for top-level comments.
+	Depth int `json:"depth"`
 
 	PostID string `json:"link_id,omitempty"`
 	// This doesn't appear consistently.
@@ -434,6 +474,9 @@ type Comment struct {
 	CanGild     bool `json:"can_gild"`
 	NSFW        bool `json:"over_18"`
 
+	Collapsed       bool   `json:"collapsed"`
+	CollapsedReason string `json:"collapsed_reason,omitempty"`
+
 	Replies Replies `json:"replies"`
 }
 
@@ -527,17 +570,45 @@ type Post struct {
 
 	Permalink string `json:"permalink,omitempty"`
 	URL       string `json:"url,omitempty"`
+	// The URL of the post's thumbnail, or one of "self", "default", "nsfw", or "spoiler".
+	Thumbnail string `json:"thumbnail,omitempty"`
+	// The dimensions of the thumbnail, if it's an image.
+	ThumbnailWidth  int `json:"thumbnail_width"`
+	ThumbnailHeight int `json:"thumbnail_height"`
+	// The kind of content Reddit detected in the post, e.g. "image", "link", or "self".
+	PostHint string `json:"post_hint,omitempty"`
 
 	Title string `json:"title,omitempty"`
 	Body  string `json:"selftext,omitempty"`
 
+	LinkFlairID              string `json:"link_flair_template_id,omitempty"`
+	LinkFlairText            string `json:"link_flair_text,omitempty"`
+	LinkFlairBackgroundColor string `json:"link_flair_background_color,omitempty"`
+	// Either "dark" or "light".
+	LinkFlairTextColor string `json:"link_flair_text_color,omitempty"`
+
+	// The default sort of the post's comments, if set by the moderators.
+	SuggestedSort string `json:"suggested_sort,omitempty"`
+
 	// Indicates if you've upvoted/downvoted (true/false).
 	// If neither, it will be nil.
 	Likes *bool `json:"likes"`
 
-	Score            int     `json:"score"`
-	UpvoteRatio      float32 `json:"upvote_ratio"`
-	NumberOfComments int     `json:"num_comments"`
+	Score               int     `json:"score"`
+	UpvoteRatio         float32 `json:"upvote_ratio"`
+	NumberOfComments    int     `json:"num_comments"`
+	NumberOfCrossposts  int     `json:"num_crossposts"`
+	TotalAwardsReceived int     `json:"total_awards_received"`
+
+	AllAwardings []*Awarding `json:"all_awardings,omitempty"`
+
+	// The full ID of the post this one was crossposted from, if any.
+	CrosspostParent string `json:"crosspost_parent,omitempty"`
+	// The post this one was crossposted from, if any.
+	CrosspostParentList []*Post `json:"crosspost_parent_list,omitempty"`
+
+	// The collections the post belongs to.
+	Collections []*Collection `json:"collections,omitempty"`
 
 	SubredditName         string `json:"subreddit,omitempty"`
 	SubredditNamePrefixed string `json:"subreddit_name_prefixed,omitempty"`
@@ -553,6 +624,52 @@ type Post struct {
 	IsSelfPost bool `json:"is_self"`
 	Saved      bool `json:"saved"`
 	Stickied   bool `json:"stickied"`
+	Pinned     bool `json:"pinned"`
+	IsVideo    bool `json:"is_video"`
+	IsMeta     bool `json:"is_meta"`
+
+	IsCrosspostable bool `json:"is_crosspostable"`
+
+	// Gallery posts are self posts whose images are listed in GalleryData.
+	IsGallery   bool         `json:"is_gallery"`
+	GalleryData *GalleryData `json:"gallery_data,omitempty"`
+
+	// The images Reddit generated to preview the post's content, if any.
+	Preview *PostPreview `json:"preview,omitempty"`
+}
+
+// PostPreview holds the preview images of a post.
+type PostPreview struct {
+	Images  []*PostPreviewImage `json:"images"`
+	Enabled bool                `json:"enabled"`
+}
+
+// PostPreviewImage is a preview image of a post, in its original size and in smaller resolutions.
+type PostPreviewImage struct {
+	ID          string                    `json:"id"`
+	Source      *PostPreviewImageSource   `json:"source"`
+	Resolutions []*PostPreviewImageSource `json:"resolutions"`
+}
+
+// PostPreviewImageSource is a preview image in a specific resolution.
+// Its URL is HTML escaped, e.g. "&" is returned as "&amp;".
+type PostPreviewImageSource struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// GalleryData holds the images of a gallery post.
+type GalleryData struct {
+	Items []*GalleryItem `json:"items"`
+}
+
+// GalleryItem is an image in a gallery post.
+type GalleryItem struct {
+	// The ID of the image uploaded to Reddit.
+	MediaID     string `json:"media_id"`
+	Caption     string `json:"caption,omitempty"`
+	OutboundURL string `json:"outbound_url,omitempty"`
 }
 
 // Subreddit holds information about a subreddit
@@ -569,12 +686,37 @@ type Subreddit struct {
 	Type                 string `json:"subreddit_type,omitempty"`
 	SuggestedCommentSort string `json:"suggested_comment_sort,omitempty"`
 
+	// The types of posts allowed in the subreddit: "any", "link", or "self".
+	SubmissionType string `json:"submission_type,omitempty"`
+	Language       string `json:"lang,omitempty"`
+
+	IconImage             string `json:"icon_img,omitempty"`
+	CommunityIcon         string `json:"community_icon,omitempty"`
+	HeaderImage           string `json:"header_img,omitempty"`
+	BannerImage           string `json:"banner_img,omitempty"`
+	BannerBackgroundImage string `json:"banner_background_image,omitempty"`
+	KeyColor              string `json:"key_color,omitempty"`
+	PrimaryColor          string `json:"primary_color,omitempty"`
+
 	Subscribers     int  `json:"subscribers"`
 	ActiveUserCount *int `json:"active_user_count,omitempty"`
 	NSFW            bool `json:"over18"`
+	Quarantined     bool `json:"quarantine"`
 	UserIsMod       bool `json:"user_is_moderator"`
+	UserIsBanned    bool `json:"user_is_banned"`
 	Subscribed      bool `json:"user_is_subscriber"`
 	Favorite        bool `json:"user_has_favorited"`
+
+	AllowImages     bool `json:"allow_images"`
+	AllowVideos     bool `json:"allow_videos"`
+	AllowVideoGIFs  bool `json:"allow_videogifs"`
+	AllowGalleries  bool `json:"allow_galleries"`
+	AllowPolls      bool `json:"allow_polls"`
+	SpoilersEnabled bool `json:"spoilers_enabled"`
+
+	// Your moderator permissions in the subreddit, e.g. "all" or "posts".
+	// Only returned when getting the subreddits you moderate.
+	ModPermissions []string `json:"mod_permissions,omitempty"`
 }
 
 // PostAndComments is a post and its comments.
@@ -22,7 +22,7 @@ func (t *Timestamp) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// Time is expected in RFC3339 or Unix format.
+// Time is expected in RFC3339 or Unix format, with the latter being either an integer or a float.
 func (t *Timestamp) UnmarshalJSON(data []byte) (err error) {
 	str := string(data)
 
@@ -31,6 +31,12 @@ func (t *Timestamp) UnmarshalJSON(data []byte) (err error) {
 		return
 	}
 
+	// Unix timestamps are sent as either integers or floats, e.g. 1618929876 or 1618929876.0.
+	if i, parseErr := strconv.ParseInt(str, 10, 64); parseErr == nil {
+		t.Time = time.Unix(i, 0).UTC()
+		return
+	}
+
 	f, err := strconv.ParseFloat(str, 64)
 	if err == nil {
 		t.Time = time.Unix(int64(f), 0).UTC()
@@ -11,6 +11,8 @@ const (
 	emptyTimeStr         = `"0001-01-01T00:00:00Z"`
 	referenceTimeStr     = `"2006-01-02T15:04:05Z"`
 	referenceUnixTimeStr = `1136214245`
+
+	referenceUnixFloatTimeStr = `1136214245.0`
 )
 
 var (
@@ -53,6 +55,7 @@ func TestTimestamp_Unmarshal(t *testing.T) {
 	}{
 		{"Reference", referenceTimeStr, Timestamp{referenceTime}, false, true},
 		{"ReferenceUnix", referenceUnixTimeStr, Timestamp{referenceTime}, false, true},
+		{"ReferenceUnixFloat", referenceUnixFloatTimeStr, Timestamp{referenceTime}, false, true},
 		{"Empty", emptyTimeStr, Timestamp{}, false, true},
 		{"UnixStart", `0`, Timestamp{unixOrigin}, false, true},
 		{"Mismatch", referenceTimeStr, Timestamp{}, false, false},
@@ -73,6 +76,25 @@ func TestTimestamp_Unmarshal(t *testing.T) {
 	}
 }
 
+func TestTimestamp_Unmarshal_IntAndFloat(t *testing.T) {
+	var fromInt, fromFloat Timestamp
+
+	if err := json.Unmarshal([]byte(`1618929876`), &fromInt); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(`1618929876.0`), &fromFloat); err != nil {
+		t.Fatal(err)
+	}
+
+	want := time.Date(2021, time.April, 20, 14, 44, 36, 0, time.UTC)
+	if fromInt.Time != want {
+		t.Fatalf("got=%v, want=%v", fromInt.Time, want)
+	}
+	if fromFloat.Time != want {
+		t.Fatalf("got=%v, want=%v", fromFloat.Time, want)
+	}
+}
+
 func TestTimstamp_MarshalReflexivity(t *testing.T) {
 	testCases := []struct {
 		desc string
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // UserService handles communication with the user
@@ -22,14 +23,23 @@ type User struct {
 	Name    string     `json:"name,omitempty"`
 	Created *Timestamp `json:"created_utc,omitempty"`
 
+	IconImage      string `json:"icon_img,omitempty"`
+	SnoovatarImage string `json:"snoovatar_img,omitempty"`
+
 	PostKarma    int `json:"link_karma"`
 	CommentKarma int `json:"comment_karma"`
+	TotalKarma   int `json:"total_karma"`
 
 	IsFriend         bool `json:"is_friend"`
 	IsEmployee       bool `json:"is_employee"`
 	HasVerifiedEmail bool `json:"has_verified_email"`
 	NSFW             bool `json:"over_18"`
 	IsSuspended      bool `json:"is_suspended"`
+	AcceptFollowers  bool `json:"accept_followers"`
+
+	// The following are only returned for your own account.
+	CanCreateSubreddit bool `json:"can_create_subreddit"`
+	ShowNSFW           bool `json:"pref_show_nsfw"`
 }
 
 // UserSummary represents a Reddit user, but
@@ -167,9 +177,19 @@ func (s *UserService) CommentsOf(ctx context.Context, username string, opts *Lis
 	return l.Comments(), resp, nil
 }
 
-// Saved returns a list of the user's saved posts and comments.
+// Saved returns a list of your saved posts and comments.
 func (s *UserService) Saved(ctx context.Context, opts *ListUserOverviewOptions) ([]*Post, []*Comment, *Response, error) {
-	path := fmt.Sprintf("user/%s/saved", s.client.Username)
+	return s.SavedOf(ctx, s.client.Username, opts)
+}
+
+// SavedOf returns a list of the user's saved posts and comments.
+// Saved items are private, so the user must be you.
+func (s *UserService) SavedOf(ctx context.Context, username string, opts *ListUserOverviewOptions) ([]*Post, []*Comment, *Response, error) {
+	if !strings.EqualFold(username, s.client.Username) {
+		return nil, nil, nil, fmt.Errorf("username %q: must be the authenticated user, since saved items are private", username)
+	}
+
+	path := fmt.Sprintf("user/%s/saved", username)
 	l, resp, err := s.client.getListing(ctx, path, opts)
 	if err != nil {
 		return nil, nil, resp, err
@@ -185,12 +205,7 @@ func (s *UserService) Upvoted(ctx context.Context, opts *ListUserOverviewOptions
 // UpvotedOf returns a list of the user's upvoted posts.
 // The user's votes must be public for this to work (unless the user is you).
 func (s *UserService) UpvotedOf(ctx context.Context, username string, opts *ListUserOverviewOptions) ([]*Post, *Response, error) {
-	path := fmt.Sprintf("user/%s/upvoted", username)
-	l, resp, err := s.client.getListing(ctx, path, opts)
-	if err != nil {
-		return nil, resp, err
-	}
-	return l.Posts(), resp, nil
+	return s.voted(ctx, username, "upvoted", opts)
 }
 
 // Downvoted returns a list of your downvoted posts.
@@ -201,9 +216,17 @@ func (s *UserService) Downvoted(ctx context.Context, opts *ListUserOverviewOptio
 // DownvotedOf returns a list of the user's downvoted posts.
 // The user's votes must be public for this to work (unless the user is you).
 func (s *UserService) DownvotedOf(ctx context.Context, username string, opts *ListUserOverviewOptions) ([]*Post, *Response, error) {
-	path := fmt.Sprintf("user/%s/downvoted", username)
+	return s.voted(ctx, username, "downvoted", opts)
+}
+
+func (s *UserService) voted(ctx context.Context, username, direction string, opts *ListUserOverviewOptions) ([]*Post, *Response, error) {
+	path := fmt.Sprintf("user/%s/%s", username, direction)
 	l, resp, err := s.client.getListing(ctx, path, opts)
 	if err != nil {
+		// Reddit doesn't say why, but a 403 here means the user's votes aren't public.
+		if resp != nil && resp.StatusCode == http.StatusForbidden {
+			err = fmt.Errorf("cannot get %s posts of user %s, their votes may be private: %w", direction, username, err)
+		}
 		return nil, resp, err
 	}
 	return l.Posts(), resp, nil
@@ -229,6 +252,16 @@ func (s *UserService) Gilded(ctx context.Context, opts *ListUserOverviewOptions)
 	return l.Posts(), resp, nil
 }
 
+// GildedOf returns a list of the user's gilded posts and comments.
+func (s *UserService) GildedOf(ctx context.Context, username string, opts *ListUserOverviewOptions) ([]*Post, []*Comment, *Response, error) {
+	path := fmt.Sprintf("user/%s/gilded", username)
+	l, resp, err := s.client.getListing(ctx, path, opts)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+	return l.Posts(), l.Comments(), resp, nil
+}
+
 // GetFriendship returns relationship details with the specified user.
 // If the user is not your friend, it will return an error.
 func (s *UserService) GetFriendship(ctx context.Context, username string) (*Relationship, *Response, error) {
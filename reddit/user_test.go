@@ -2,6 +2,7 @@ package reddit
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -16,6 +17,8 @@ var expectedUser = &User{
 	Name:    "Test_User",
 	Created: &Timestamp{time.Date(2012, 10, 18, 10, 11, 11, 0, time.UTC)},
 
+	IconImage: "https://www.redditstatic.com/avatars/avatar_default_16_25B79F.png",
+
 	PostKarma:    8239,
 	CommentKarma: 130514,
 
@@ -73,6 +76,13 @@ var expectedPost = &Post{
 	AuthorID: "t2_164ab8",
 
 	IsSelfPost: true,
+
+	Thumbnail:          "self",
+	LinkFlairID:        "c4edd5ce-40e8-11e7-b814-0ef91bd65558",
+	LinkFlairText:      "Reddit API",
+	LinkFlairTextColor: "dark",
+	IsCrosspostable:    true,
+	AllAwardings:       []*Awarding{},
 }
 
 var expectedComment = &Comment{
@@ -97,11 +107,15 @@ var expectedComment = &Comment{
 	Score:            1,
 	Controversiality: 0,
 
+	AllAwardings: []*Awarding{},
+
 	PostID:          "t3_d7ejpn",
 	PostTitle:       "I'm giving away an iPhone 11 Pro to a commenter at random to celebrate Apollo for Reddit's new iOS 13 update and as a thank you to the community! Just leave a comment on this post and the winner will be selected randomly and announced tomorrow at 8 PM GMT. Details inside, and good luck!",
 	PostPermalink:   "https://www.reddit.com/r/apple/comments/d7ejpn/im_giving_away_an_iphone_11_pro_to_a_commenter_at/",
 	PostAuthor:      "iamthatis",
 	PostNumComments: Int(89751),
+
+	AuthorFlairRichtext: []map[string]string{},
 }
 
 var expectedRelationship = &Relationship{
@@ -142,6 +156,19 @@ var expectedUserSubreddits = []*Subreddit{
 		Title:        "nickofnight",
 		Description:  "Stories written for Writing Prompts, NoSleep, and originals. Current series: The Carnival of Night ",
 		Type:         "user",
+
+		SubmissionType: "any",
+		Language:       "en",
+		IconImage:      "https://styles.redditmedia.com/t5_3kefx/styles/profileIcon_w1vytyimts541.png?width=256&amp;height=256&amp;crop=256:256,smart&amp;s=e722798c6253d3ae3990bf42c3ae844d7c2a924b",
+		BannerImage:    "https://b.thumbs.redditmedia.com/9KgnD8_adeV_jCLhObwY-rhHrESHgTP9_JQLmIH_GWQ.png",
+		KeyColor:       "#222222",
+
+		AllowImages:     true,
+		AllowVideos:     true,
+		AllowVideoGIFs:  true,
+		AllowGalleries:  true,
+		AllowPolls:      true,
+		SpoilersEnabled: true,
 	},
 	{
 		ID:      "3knn1",
@@ -155,6 +182,18 @@ var expectedUserSubreddits = []*Subreddit{
 		Description:          "In nineteen ninety eight the undertaker threw mankind off hеll in a cell, and plummeted sixteen feet through an announcer's table.",
 		Type:                 "user",
 		SuggestedCommentSort: "qa",
+
+		SubmissionType: "any",
+		Language:       "en",
+		IconImage:      "https://styles.redditmedia.com/t5_3knn1/styles/profileIcon_b51xzp4vbvs41.jpg?width=256&amp;height=256&amp;crop=256:256,smart&amp;s=6535d6f05d037d43d72217899d3f81aba4fb442d",
+		BannerImage:    "https://b.thumbs.redditmedia.com/VjGAJxyj4OL3Ghb1TzrGFtf1QT3D-r1kX72q7uSv8iA.png",
+
+		AllowImages:     true,
+		AllowVideos:     true,
+		AllowVideoGIFs:  true,
+		AllowGalleries:  true,
+		AllowPolls:      true,
+		SpoilersEnabled: true,
 	},
 }
 
@@ -164,6 +203,8 @@ var expectedSearchUsers = []*User{
 		Name:    "washingtonpost",
 		Created: &Timestamp{time.Date(2017, 4, 20, 21, 23, 58, 0, time.UTC)},
 
+		IconImage: "https://styles.redditmedia.com/t5_3kdh5/styles/profileIcon_0ws73gmqq8t21.png?width=256&amp;height=256&amp;crop=256:256,smart&amp;s=a4d69298f5514b44cfa28a428c0953ebe0d5f6a1",
+
 		PostKarma:    1075227,
 		CommentKarma: 339569,
 
@@ -174,6 +215,8 @@ var expectedSearchUsers = []*User{
 		Name:    "reuters",
 		Created: &Timestamp{time.Date(2018, 3, 15, 1, 50, 4, 0, time.UTC)},
 
+		IconImage: "https://styles.redditmedia.com/t5_i4xj7/styles/profileIcon_mlsb0hlsebs01.jpg?width=256&amp;height=256&amp;crop=256:256,smart&amp;s=7cb6c6fcf5079cd5514ea626e73398429f3b4b54",
+
 		PostKarma:    76744,
 		CommentKarma: 42717,
 
@@ -197,6 +240,22 @@ func TestUserService_Get(t *testing.T) {
 	require.Equal(t, expectedUser, user)
 }
 
+func TestUserService_Get_NotFound(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/user/Test_User/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "Not Found", "error": 404}`)
+	})
+
+	user, resp, err := client.User.Get(ctx, "Test_User")
+	require.IsType(t, &ErrorResponse{}, err)
+	require.Equal(t, "Not Found", err.(*ErrorResponse).Message)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	require.Nil(t, user)
+}
+
 func TestUserService_GetMultipleByID(t *testing.T) {
 	client, mux := setup(t)
 
@@ -289,6 +348,42 @@ func TestUserService_OverviewOf(t *testing.T) {
 	require.Equal(t, "t1_f0zsa37", resp.After)
 }
 
+func TestUserService_OverviewOf_Interleaved(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/user/user2/overview", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{
+			"kind": "Listing",
+			"data": {
+				"children": [
+					{"kind": "t1", "data": {"id": "c1", "name": "t1_c1"}},
+					{"kind": "t3", "data": {"id": "p1", "name": "t3_p1"}},
+					{"kind": "t1", "data": {"id": "c2", "name": "t1_c2"}},
+					{"kind": "t3", "data": {"id": "p2", "name": "t3_p2"}},
+					{"kind": "t3", "data": {"id": "p3", "name": "t3_p3"}}
+				],
+				"after": null,
+				"before": null
+			}
+		}`)
+	})
+
+	posts, comments, _, err := client.User.OverviewOf(ctx, "user2", nil)
+	require.NoError(t, err)
+
+	// each kind keeps the order of the listing
+	require.Len(t, posts, 3)
+	for i, post := range posts {
+		require.Equal(t, fmt.Sprintf("t3_p%d", i+1), post.FullID)
+	}
+
+	require.Len(t, comments, 2)
+	for i, comment := range comments {
+		require.Equal(t, fmt.Sprintf("t1_c%d", i+1), comment.FullID)
+	}
+}
+
 func TestUserService_Overview_Options(t *testing.T) {
 	client, mux := setup(t)
 
@@ -478,6 +573,27 @@ func TestUserService_Saved(t *testing.T) {
 	require.Equal(t, "t1_f0zsa37", resp.After)
 }
 
+func TestUserService_SavedOf(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/user/overview.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/user/User1/saved", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, _, err = client.User.SavedOf(ctx, "user2", nil)
+	require.EqualError(t, err, `username "user2": must be the authenticated user, since saved items are private`)
+
+	// usernames are case insensitive
+	posts, comments, _, err := client.User.SavedOf(ctx, "User1", nil)
+	require.NoError(t, err)
+	require.Equal(t, []*Post{expectedPost}, posts)
+	require.Equal(t, []*Comment{expectedComment}, comments)
+}
+
 func TestUserService_Saved_Options(t *testing.T) {
 	client, mux := setup(t)
 
@@ -647,6 +763,30 @@ func TestUserService_DownvotedOf(t *testing.T) {
 	require.Equal(t, "t3_gczwql", resp.After)
 }
 
+func TestUserService_VotedOf_PrivateVotes(t *testing.T) {
+	client, mux := setup(t)
+
+	for _, direction := range []string{"upvoted", "downvoted"} {
+		mux.HandleFunc("/user/user2/"+direction, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodGet, r.Method)
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message": "Forbidden", "error": 403}`)
+		})
+	}
+
+	_, resp, err := client.User.UpvotedOf(ctx, "user2", nil)
+	require.EqualError(t, err, fmt.Sprintf("cannot get upvoted posts of user user2, their votes may be private: GET %s/user/user2/upvoted: 403 Forbidden", client.BaseURL))
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	var errorResponse *ErrorResponse
+	require.True(t, errors.As(err, &errorResponse))
+	require.Equal(t, "Forbidden", errorResponse.Message)
+
+	_, resp, err = client.User.DownvotedOf(ctx, "user2", nil)
+	require.EqualError(t, err, fmt.Sprintf("cannot get downvoted posts of user user2, their votes may be private: GET %s/user/user2/downvoted: 403 Forbidden", client.BaseURL))
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
 func TestUserService_Hidden(t *testing.T) {
 	client, mux := setup(t)
 
@@ -687,6 +827,30 @@ func TestUserService_Gilded(t *testing.T) {
 	require.Equal(t, "t3_gczwql", resp.After)
 }
 
+func TestUserService_GildedOf(t *testing.T) {
+	client, mux := setup(t)
+
+	// we'll use this, similar payloads
+	blob, err := readFileContents("../testdata/user/overview.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/user/user2/gilded", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	posts, comments, resp, err := client.User.GildedOf(ctx, "user2", nil)
+	require.NoError(t, err)
+
+	require.Len(t, posts, 1)
+	require.Equal(t, expectedPost, posts[0])
+
+	require.Len(t, comments, 1)
+	require.Equal(t, expectedComment, comments[0])
+
+	require.Equal(t, "t1_f0zsa37", resp.After)
+}
+
 func TestUserService_GetFriendship(t *testing.T) {
 	client, mux := setup(t)
 
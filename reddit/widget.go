@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 // WidgetService handles communication with the widget
@@ -37,6 +38,7 @@ const (
 	widgetKindModerators       = "moderators"
 	widgetKindSubredditRules   = "subreddit-rules"
 	widgetKindCustom           = "custom"
+	widgetKindCalendar         = "calendar"
 )
 
 type rootWidget struct {
@@ -73,6 +75,8 @@ func (w *rootWidget) UnmarshalJSON(data []byte) error {
 		w.Data = new(SubredditRulesWidget)
 	case widgetKindCustom:
 		w.Data = new(CustomWidget)
+	case widgetKindCalendar:
+		w.Data = new(CalendarWidget)
 	default:
 		return fmt.Errorf("unrecognized widget kind: %q", root.Kind)
 	}
@@ -254,6 +258,36 @@ type CustomWidget struct {
 	StyleSheet    string         `json:"css,omitempty"`
 	StyleSheetURL string         `json:"stylesheetUrl,omitempty"`
 	Images        []*WidgetImage `json:"imageData,omitempty"`
+	// The height of the widget, in pixels.
+	Height int `json:"height,omitempty"`
+}
+
+// CalendarWidget displays upcoming events from a Google Calendar.
+type CalendarWidget struct {
+	widget
+
+	Name             string                       `json:"shortName,omitempty"`
+	GoogleCalendarID string                       `json:"googleCalendarId,omitempty"`
+	RequiresSync     bool                         `json:"requiresSync"`
+	Configuration    *WidgetCalendarConfiguration `json:"configuration,omitempty"`
+}
+
+// WidgetCalendarConfiguration controls which events of a calendar widget are shown, and how.
+type WidgetCalendarConfiguration struct {
+	// Between 1 and 50.
+	NumEvents       int  `json:"numEvents"`
+	ShowDate        bool `json:"showDate"`
+	ShowDescription bool `json:"showDescription"`
+	ShowLocation    bool `json:"showLocation"`
+	ShowTime        bool `json:"showTime"`
+	ShowTitle       bool `json:"showTitle"`
+}
+
+func (c *WidgetCalendarConfiguration) validate() error {
+	if c.NumEvents < 1 || c.NumEvents > 50 {
+		return fmt.Errorf("NumEvents: %d is not between 1 and 50", c.NumEvents)
+	}
+	return nil
 }
 
 // WidgetStyle contains style information for the widget.
@@ -334,6 +368,21 @@ type WidgetImageLink struct {
 	LinkURL string `json:"linkURL,omitempty"`
 }
 
+// widgetImageHosts are the URL prefixes of the places Reddit serves widget images from.
+var widgetImageHosts = []string{"https://i.redd.it/", "https://preview.redd.it/"}
+
+func (l *WidgetImageLink) validate() error {
+	if l.URL == "" {
+		return errors.New("URL: cannot be empty")
+	}
+	for _, host := range widgetImageHosts {
+		if strings.HasPrefix(l.URL, host) {
+			return nil
+		}
+	}
+	return fmt.Errorf("URL: %q must be hosted on Reddit", l.URL)
+}
+
 // WidgetCommunity is a community (subreddit) that's displayed in a widget.
 type WidgetCommunity struct {
 	Name        string `json:"name,omitempty"`
@@ -343,9 +392,18 @@ type WidgetCommunity struct {
 }
 
 // WidgetButton is a button that's part of a widget.
+// Text buttons link to URL. Image buttons display the image at URL and link to LinkURL.
 type WidgetButton struct {
-	Text      string `json:"text,omitempty"`
-	URL       string `json:"url,omitempty"`
+	// One of: text, image.
+	Kind string `json:"kind,omitempty"`
+	// For image buttons, this is the image's alt text.
+	Text    string `json:"text,omitempty"`
+	URL     string `json:"url,omitempty"`
+	LinkURL string `json:"linkUrl,omitempty"`
+	// The dimensions of image buttons, in pixels.
+	Width  int `json:"width,omitempty"`
+	Height int `json:"height,omitempty"`
+
 	TextColor string `json:"textColor,omitempty"`
 	FillColor string `json:"fillColor,omitempty"`
 	// The color of the button's "outline".
@@ -408,6 +466,107 @@ func (r *CommunityListWidgetCreateRequest) MarshalJSON() ([]byte, error) {
 	}{r.requestKind(), r.Style, r.Name, r.Communities})
 }
 
+// ImageWidgetCreateRequest represents a request to create an image widget.
+type ImageWidgetCreateRequest struct {
+	Style *WidgetStyle `json:"styles,omitempty"`
+	// No longer than 30 characters.
+	Name string `json:"shortName,omitempty"`
+	// Up to 10 images. They must be hosted on Reddit, i.e. on i.redd.it or preview.redd.it.
+	Images []*WidgetImageLink `json:"data,omitempty"`
+}
+
+func (*ImageWidgetCreateRequest) requestKind() string { return widgetKindImage }
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r *ImageWidgetCreateRequest) MarshalJSON() ([]byte, error) {
+	for i, image := range r.Images {
+		if image == nil {
+			return nil, &JSONError{Message: fmt.Sprintf("(*ImageWidgetCreateRequest).Images[%d]: cannot be nil", i)}
+		}
+		if err := image.validate(); err != nil {
+			return nil, &JSONError{Message: fmt.Sprintf("(*ImageWidgetCreateRequest).Images[%d]: %s", i, err)}
+		}
+	}
+
+	return json.Marshal(struct {
+		Kind   string             `json:"kind"`
+		Style  *WidgetStyle       `json:"styles,omitempty"`
+		Name   string             `json:"shortName,omitempty"`
+		Images []*WidgetImageLink `json:"data,omitempty"`
+	}{r.requestKind(), r.Style, r.Name, r.Images})
+}
+
+// CustomWidgetCreateRequest represents a request to create a custom widget.
+type CustomWidgetCreateRequest struct {
+	Style *WidgetStyle `json:"styles,omitempty"`
+	// No longer than 30 characters.
+	Name string `json:"shortName,omitempty"`
+	// Raw markdown text.
+	Text       string         `json:"text,omitempty"`
+	StyleSheet string         `json:"css,omitempty"`
+	Images     []*WidgetImage `json:"imageData,omitempty"`
+	// The height of the widget, in pixels. Between 50 and 500.
+	Height int `json:"height"`
+}
+
+func (*CustomWidgetCreateRequest) requestKind() string { return widgetKindCustom }
+
+func (r *CustomWidgetCreateRequest) validate() error {
+	if r.Height < 50 || r.Height > 500 {
+		return fmt.Errorf("(*CustomWidgetCreateRequest).Height: %d is not between 50 and 500", r.Height)
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r *CustomWidgetCreateRequest) MarshalJSON() ([]byte, error) {
+	if err := r.validate(); err != nil {
+		return nil, &JSONError{Message: err.Error()}
+	}
+
+	return json.Marshal(struct {
+		Kind       string         `json:"kind"`
+		Style      *WidgetStyle   `json:"styles,omitempty"`
+		Name       string         `json:"shortName,omitempty"`
+		Text       string         `json:"text,omitempty"`
+		StyleSheet string         `json:"css,omitempty"`
+		Images     []*WidgetImage `json:"imageData,omitempty"`
+		Height     int            `json:"height"`
+	}{r.requestKind(), r.Style, r.Name, r.Text, r.StyleSheet, r.Images, r.Height})
+}
+
+// CalendarWidgetCreateRequest represents a request to create a calendar widget.
+type CalendarWidgetCreateRequest struct {
+	Style *WidgetStyle `json:"styles,omitempty"`
+	// No longer than 30 characters.
+	Name string `json:"shortName,omitempty"`
+	// The id of a public Google Calendar.
+	GoogleCalendarID string                       `json:"googleCalendarId"`
+	RequiresSync     bool                         `json:"requiresSync"`
+	Configuration    *WidgetCalendarConfiguration `json:"configuration"`
+}
+
+func (*CalendarWidgetCreateRequest) requestKind() string { return widgetKindCalendar }
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r *CalendarWidgetCreateRequest) MarshalJSON() ([]byte, error) {
+	if r.Configuration == nil {
+		return nil, &JSONError{Message: "(*CalendarWidgetCreateRequest).Configuration: cannot be nil"}
+	}
+	if err := r.Configuration.validate(); err != nil {
+		return nil, &JSONError{Message: fmt.Sprintf("(*CalendarWidgetCreateRequest).Configuration: %s", err)}
+	}
+
+	return json.Marshal(struct {
+		Kind             string                       `json:"kind"`
+		Style            *WidgetStyle                 `json:"styles,omitempty"`
+		Name             string                       `json:"shortName,omitempty"`
+		GoogleCalendarID string                       `json:"googleCalendarId"`
+		RequiresSync     bool                         `json:"requiresSync"`
+		Configuration    *WidgetCalendarConfiguration `json:"configuration"`
+	}{r.requestKind(), r.Style, r.Name, r.GoogleCalendarID, r.RequiresSync, r.Configuration})
+}
+
 // Get the subreddit's widgets.
 func (s *WidgetService) Get(ctx context.Context, subreddit string) ([]Widget, *Response, error) {
 	path := fmt.Sprintf("r/%s/api/widgets?progressive_images=true", subreddit)
@@ -469,3 +628,47 @@ func (s *WidgetService) Reorder(ctx context.Context, subreddit string, ids []str
 	}
 	return s.client.Do(ctx, req, nil)
 }
+
+// ReorderAll reorders the widgets in the subreddit, like Reorder, but first checks that
+// ids holds exactly the subreddit's current sidebar widgets, so that mistakes are caught
+// before Reddit rejects the order.
+func (s *WidgetService) ReorderAll(ctx context.Context, subreddit string, ids []string) (*Response, error) {
+	widgets, resp, err := s.Get(ctx, subreddit)
+	if err != nil {
+		return resp, err
+	}
+
+	current := make(map[string]bool, len(widgets))
+	for _, w := range widgets {
+		// the menu widget lives in the topbar, and the other two are always pinned to the top of the sidebar
+		switch w.kind() {
+		case widgetKindMenu, widgetKindCommunityDetails, widgetKindModerators:
+			continue
+		}
+		current[w.GetID()] = true
+	}
+
+	var unknown []string
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if !current[id] {
+			unknown = append(unknown, id)
+			continue
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("ids: %s is provided more than once", id)
+		}
+		seen[id] = true
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("ids: %s: not sidebar widgets of the subreddit", strings.Join(unknown, ", "))
+	}
+
+	for _, w := range widgets {
+		if id := w.GetID(); current[id] && !seen[id] {
+			return nil, fmt.Errorf("ids: %s is a sidebar widget of the subreddit but was not provided", id)
+		}
+	}
+
+	return s.Reorder(ctx, subreddit, ids)
+}
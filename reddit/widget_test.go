@@ -2,6 +2,7 @@ package reddit
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -34,6 +35,7 @@ var expectedWidgets = []Widget{
 		Description: "test description",
 		Buttons: []*WidgetButton{
 			{
+				Kind:        "text",
 				Text:        "test text",
 				URL:         "https://example.com",
 				TextColor:   "#ff66ac",
@@ -162,6 +164,7 @@ var expectedWidgets = []Widget{
 		Text:          "some image",
 		StyleSheet:    "* {}",
 		StyleSheetURL: "https://styles.redditmedia.com/t5_2uquw1/styles/customWidget-stylesheet-n2q86gjf04o51.css",
+		Height:        500,
 		Images: []*WidgetImage{
 			{
 				Name: "test",
@@ -237,6 +240,105 @@ func TestWidgetService_Create(t *testing.T) {
 	}, createdWidget)
 }
 
+func TestImageWidgetCreateRequest_MarshalJSON(t *testing.T) {
+	request := &ImageWidgetCreateRequest{
+		Name: "test name",
+		Images: []*WidgetImageLink{
+			{URL: "https://i.redd.it/abc.png", LinkURL: "https://example.com"},
+			{URL: "https://preview.redd.it/def.jpg"},
+		},
+	}
+
+	b, err := json.Marshal(request)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"kind": "image",
+		"shortName": "test name",
+		"data": [
+			{"url": "https://i.redd.it/abc.png", "linkURL": "https://example.com"},
+			{"url": "https://preview.redd.it/def.jpg"}
+		]
+	}`, string(b))
+
+	request.Images[1].URL = "https://example.com/def.jpg"
+	_, err = json.Marshal(request)
+	var jsonErr *JSONError
+	require.True(t, errors.As(err, &jsonErr))
+	require.Equal(t, `(*ImageWidgetCreateRequest).Images[1]: URL: "https://example.com/def.jpg" must be hosted on Reddit`, jsonErr.Message)
+
+	request.Images[1].URL = ""
+	_, err = json.Marshal(request)
+	require.True(t, errors.As(err, &jsonErr))
+	require.Equal(t, "(*ImageWidgetCreateRequest).Images[1]: URL: cannot be empty", jsonErr.Message)
+
+	client, _ := setup(t)
+	_, _, err = client.Widget.Create(ctx, "testsubreddit", request)
+	require.True(t, errors.As(err, &jsonErr))
+}
+
+func TestCustomWidgetCreateRequest_MarshalJSON(t *testing.T) {
+	request := &CustomWidgetCreateRequest{
+		Name:   "test name",
+		Text:   "test text",
+		Height: 49,
+	}
+
+	_, err := json.Marshal(request)
+	var jsonErr *JSONError
+	require.True(t, errors.As(err, &jsonErr))
+	require.Equal(t, "(*CustomWidgetCreateRequest).Height: 49 is not between 50 and 500", jsonErr.Message)
+
+	request.Height = 501
+	_, err = json.Marshal(request)
+	require.True(t, errors.As(err, &jsonErr))
+	require.Equal(t, "(*CustomWidgetCreateRequest).Height: 501 is not between 50 and 500", jsonErr.Message)
+
+	request.Height = 500
+	b, err := json.Marshal(request)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"kind": "custom", "shortName": "test name", "text": "test text", "height": 500}`, string(b))
+}
+
+func TestCalendarWidgetCreateRequest_MarshalJSON(t *testing.T) {
+	request := &CalendarWidgetCreateRequest{
+		Name:             "test name",
+		GoogleCalendarID: "test@group.calendar.google.com",
+	}
+
+	_, err := json.Marshal(request)
+	var jsonErr *JSONError
+	require.True(t, errors.As(err, &jsonErr))
+	require.Equal(t, "(*CalendarWidgetCreateRequest).Configuration: cannot be nil", jsonErr.Message)
+
+	request.Configuration = &WidgetCalendarConfiguration{NumEvents: 0}
+	_, err = json.Marshal(request)
+	require.True(t, errors.As(err, &jsonErr))
+	require.Equal(t, "(*CalendarWidgetCreateRequest).Configuration: NumEvents: 0 is not between 1 and 50", jsonErr.Message)
+
+	request.Configuration.NumEvents = 51
+	_, err = json.Marshal(request)
+	require.True(t, errors.As(err, &jsonErr))
+	require.Equal(t, "(*CalendarWidgetCreateRequest).Configuration: NumEvents: 51 is not between 1 and 50", jsonErr.Message)
+
+	request.Configuration.NumEvents = 50
+	b, err := json.Marshal(request)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"kind": "calendar",
+		"shortName": "test name",
+		"googleCalendarId": "test@group.calendar.google.com",
+		"requiresSync": false,
+		"configuration": {
+			"numEvents": 50,
+			"showDate": false,
+			"showDescription": false,
+			"showLocation": false,
+			"showTime": false,
+			"showTitle": false
+		}
+	}`, string(b))
+}
+
 func TestWidgetService_Delete(t *testing.T) {
 	client, mux := setup(t)
 
@@ -263,3 +365,138 @@ func TestWidgetService_Reorder(t *testing.T) {
 	_, err := client.Widget.Reorder(ctx, "testsubreddit", []string{"test1", "test2", "test3", "test4"})
 	require.NoError(t, err)
 }
+
+func TestWidgetService_ReorderAll(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/widget/widgets.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/api/widgets", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	sidebar := []string{
+		"widget_rules-2uquw1",
+		"widget_15p7borvnnw5a",
+		"widget_15paxrbiodp8v",
+		"widget_15p7o01nqr5tu",
+		"widget_15p7qwb2kxc6j",
+		"widget_15osq4jms4tdo",
+	}
+
+	mux.HandleFunc("/r/testsubreddit/api/widget_order/sidebar", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method)
+
+		var ids []string
+		err := json.NewDecoder(r.Body).Decode(&ids)
+		require.NoError(t, err)
+		require.Equal(t, sidebar, ids)
+	})
+
+	_, err = client.Widget.ReorderAll(ctx, "testsubreddit", append([]string{"widget_unknown", "widget_id-card-2uquw1"}, sidebar...))
+	require.EqualError(t, err, "ids: widget_unknown, widget_id-card-2uquw1: not sidebar widgets of the subreddit")
+
+	_, err = client.Widget.ReorderAll(ctx, "testsubreddit", append(sidebar, "widget_rules-2uquw1"))
+	require.EqualError(t, err, "ids: widget_rules-2uquw1 is provided more than once")
+
+	_, err = client.Widget.ReorderAll(ctx, "testsubreddit", sidebar[1:])
+	require.EqualError(t, err, "ids: widget_rules-2uquw1 is a sidebar widget of the subreddit but was not provided")
+
+	_, err = client.Widget.ReorderAll(ctx, "testsubreddit", sidebar)
+	require.NoError(t, err)
+}
+
+func TestWidget_RoundTrip(t *testing.T) {
+	widgets := []Widget{
+		&TextAreaWidget{
+			widget: widget{ID: "widget_1", Kind: widgetKindTextArea, Style: &WidgetStyle{HeaderColor: "#373c3f"}},
+			Name:   "test text area",
+			Text:   "this is some text",
+		},
+		&ButtonWidget{
+			widget:      widget{ID: "widget_2", Kind: widgetKindButton},
+			Name:        "test buttons",
+			Description: "description",
+			Buttons: []*WidgetButton{
+				{
+					Kind:        "text",
+					Text:        "click me",
+					URL:         "https://example.com",
+					StrokeColor: "#000000",
+					HoverState:  &WidgetButtonHoverState{Text: "hovering", FillColor: "#ffffff"},
+				},
+				{
+					Kind:    "image",
+					Text:    "alt text",
+					URL:     "https://i.redd.it/button.png",
+					LinkURL: "https://example.com/image",
+					Width:   200,
+					Height:  60,
+				},
+			},
+		},
+		&ImageWidget{
+			widget: widget{ID: "widget_3", Kind: widgetKindImage},
+			Name:   "test image",
+			Images: []*WidgetImageLink{{URL: "https://i.redd.it/test.png", LinkURL: "https://example.com"}},
+		},
+		&CommunityListWidget{
+			widget:      widget{ID: "widget_4", Kind: widgetKindCommunityList},
+			Name:        "test community list",
+			Communities: []*WidgetCommunity{{Name: "golang", Subscribers: 100, Subscribed: true}},
+		},
+		&MenuWidget{
+			widget:   widget{ID: "widget_5", Kind: widgetKindMenu},
+			ShowWiki: true,
+			Links: WidgetLinkList{
+				&WidgetLinkSingle{Text: "link", URL: "https://example.com"},
+				&WidgetLinkMultiple{Text: "dropdown", URLs: []*WidgetLinkSingle{{Text: "link 2", URL: "https://example.com/2"}}},
+			},
+		},
+		&CommunityDetailsWidget{
+			widget:               widget{ID: "widget_6", Kind: widgetKindCommunityDetails},
+			Name:                 "test community",
+			Description:          "description",
+			Subscribers:          100,
+			CurrentlyViewing:     5,
+			SubscribersText:      "gophers",
+			CurrentlyViewingText: "online",
+		},
+		&CustomWidget{
+			widget:        widget{ID: "widget_7", Kind: widgetKindCustom},
+			Name:          "test custom",
+			Text:          "text",
+			StyleSheet:    "* {}",
+			StyleSheetURL: "https://example.com/style.css",
+			Images:        []*WidgetImage{{Name: "image", URL: "https://i.redd.it/test.png"}},
+			Height:        200,
+		},
+		&CalendarWidget{
+			widget:           widget{ID: "widget_8", Kind: widgetKindCalendar},
+			Name:             "test calendar",
+			GoogleCalendarID: "test@group.calendar.google.com",
+			RequiresSync:     true,
+			Configuration:    &WidgetCalendarConfiguration{NumEvents: 10, ShowDate: true, ShowTitle: true},
+		},
+	}
+
+	for _, w := range widgets {
+		t.Run(w.kind(), func(t *testing.T) {
+			b, err := json.Marshal(w)
+			require.NoError(t, err)
+
+			root := new(rootWidget)
+			err = json.Unmarshal(b, root)
+			require.NoError(t, err)
+			require.Equal(t, w, root.Data)
+		})
+	}
+}
+
+func TestWidget_UnmarshalJSON_UnknownKind(t *testing.T) {
+	root := new(rootWidget)
+	err := json.Unmarshal([]byte(`{"kind": "unknown"}`), root)
+	require.EqualError(t, err, `unrecognized widget kind: "unknown"`)
+}
@@ -22,11 +22,15 @@ var expectedWikiPage = &WikiPage{
 		Name:    "v_95",
 		Created: &Timestamp{time.Date(2017, 3, 12, 4, 56, 47, 0, time.UTC)},
 
+		IconImage: "https://www.redditstatic.com/avatars/avatar_default_01_94E044.png",
+
 		PostKarma:    691,
 		CommentKarma: 22235,
 
 		HasVerifiedEmail: true,
 		NSFW:             true,
+
+		CanCreateSubreddit: true,
 	},
 }
 
@@ -39,11 +43,15 @@ var expectedWikiPageSettings = &WikiPageSettings{
 			Name:    "v_95",
 			Created: &Timestamp{time.Date(2017, 3, 12, 4, 56, 47, 0, time.UTC)},
 
+			IconImage: "https://www.redditstatic.com/avatars/avatar_default_01_94E044.png",
+
 			PostKarma:    691,
 			CommentKarma: 22235,
 
 			HasVerifiedEmail: true,
 			NSFW:             true,
+
+			CanCreateSubreddit: true,
 		},
 	},
 }
@@ -73,6 +81,10 @@ var expectedWikiPageDiscussions = []*Post{
 
 		Author:   "v_95",
 		AuthorID: "t2_164ab8",
+
+		Thumbnail:          "default",
+		LinkFlairTextColor: "dark",
+		AllAwardings:       []*Awarding{},
 	},
 }
 
@@ -88,11 +100,15 @@ var expectedWikiPageRevisions = []*WikiPageRevision{
 			Name:    "v_95",
 			Created: &Timestamp{time.Date(2017, 3, 12, 4, 56, 47, 0, time.UTC)},
 
+			IconImage: "https://www.redditstatic.com/avatars/avatar_default_01_94E044.png",
+
 			PostKarma:    691,
 			CommentKarma: 22235,
 
 			HasVerifiedEmail: true,
 			NSFW:             true,
+
+			CanCreateSubreddit: true,
 		},
 	},
 	{
@@ -106,11 +122,15 @@ var expectedWikiPageRevisions = []*WikiPageRevision{
 			Name:    "v_95",
 			Created: &Timestamp{time.Date(2017, 3, 12, 4, 56, 47, 0, time.UTC)},
 
+			IconImage: "https://www.redditstatic.com/avatars/avatar_default_01_94E044.png",
+
 			PostKarma:    691,
 			CommentKarma: 22235,
 
 			HasVerifiedEmail: true,
 			NSFW:             true,
+
+			CanCreateSubreddit: true,
 		},
 	},
 }